@@ -0,0 +1,5 @@
+resource "scp_app_feature_enablement" "example" {
+  app_group    = "example_app_group"
+  feature_name = "example_feature"
+  enabled      = true
+}
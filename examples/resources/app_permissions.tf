@@ -0,0 +1,12 @@
+resource "scp_splunkbase_app" "broken_hosts" {
+  name              = "broken_hosts"
+  acs_licensing_ack = "https://opensource.org/licenses/MIT"
+  version           = "5.0.4"
+  splunkbase_id     = "3247"
+}
+
+resource "scp_app_permissions" "broken_hosts" {
+  app   = scp_splunkbase_app.broken_hosts.name
+  read  = ["user", "power"]
+  write = ["sc_admin"]
+}
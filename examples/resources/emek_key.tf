@@ -0,0 +1,7 @@
+data "scp_emek_policy" "stack" {
+  emek_legal_ack = "Y"
+}
+
+resource "scp_emek_key" "stack" {
+  key_arn = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+}
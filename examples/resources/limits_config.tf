@@ -0,0 +1,7 @@
+resource "scp_limits_config" "search" {
+  stanza = "search"
+  settings = {
+    max_rt_search_multiplier = "2"
+    max_searches_per_cpu     = "2"
+  }
+}
@@ -0,0 +1,15 @@
+resource "scp_maintenance_window_preferences" "change-freezes" {
+  customer_initiated_freeze {
+    applies_to = "all"
+    start_date = "2026/11/20"
+    end_date   = "2026/11/30"
+    reason     = "Black Friday and Cyber Monday"
+  }
+
+  customer_initiated_freeze {
+    applies_to = "all"
+    start_date = "2026/12/20"
+    end_date   = "2027/01/02"
+    reason     = "End of year holidays"
+  }
+}
@@ -0,0 +1,15 @@
+resource "scp_managed_glue_resources" "federated_s3" {
+  managed_glue_resource {
+    cloud_provider  = "aws"
+    database        = "security_logs"
+    table           = "cloudtrail"
+    location_prefix = "s3://example-cloudtrail-bucket/AWSLogs/"
+    file_format     = "json"
+    source_type     = "aws:cloudtrail"
+
+    partition_projection {
+      account_ids = ["123456789012"]
+      regions     = ["us-east-1", "us-west-2"]
+    }
+  }
+}
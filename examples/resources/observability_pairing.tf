@@ -0,0 +1,4 @@
+resource "scp_observability_pairing" "o11y" {
+  realm       = "us1"
+  admin_token = "<observability-admin-access-token>"
+}
@@ -0,0 +1,9 @@
+resource "scp_outbound_ports" "db-connect" {
+  port               = 3306
+  destination_ranges = ["###.0.0.0/24", "##.0.10.6/32"]
+}
+
+resource "scp_outbound_ports" "modular-input" {
+  port               = 8443
+  destination_ranges = ["###.0.0.0/24"]
+}
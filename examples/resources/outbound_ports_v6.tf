@@ -0,0 +1,4 @@
+resource "scp_outbound_ports_v6" "db-connect" {
+  port               = 3306
+  destination_ranges = ["2001:db8::/32", "2001:db8:1::10/128"]
+}
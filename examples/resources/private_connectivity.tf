@@ -0,0 +1,13 @@
+resource "scp_private_connectivity" "stack" {
+  features             = ["search", "ingest"]
+  customer_account_ids = ["123456789012"]
+}
+
+// The endpoint service names can be passed to VPC endpoints of the AWS provider, for example:
+//
+// resource "aws_vpc_endpoint" "splunk" {
+//   for_each          = { for endpoint in scp_private_connectivity.stack.endpoints : endpoint.feature => endpoint }
+//   vpc_id            = aws_vpc.main.id
+//   service_name      = each.value.endpoint
+//   vpc_endpoint_type = "Interface"
+// }
@@ -0,0 +1,3 @@
+resource "scp_python_version" "stack" {
+  python_version = "python3.9"
+}
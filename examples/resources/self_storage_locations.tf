@@ -0,0 +1,12 @@
+resource "scp_self_storage_location" "archive" {
+  bucket_name = "<prefix>-archive-bucket"
+  folder      = "indexes"
+  title       = "archive"
+  description = "Self storage location for archived index data"
+}
+
+resource "scp_indexes" "archived-index" {
+  name                     = "archived-index"
+  searchable_days          = 90
+  self_storage_bucket_path = scp_self_storage_location.archive.bucket_path
+}
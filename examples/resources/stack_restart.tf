@@ -0,0 +1,6 @@
+resource "scp_stack_restart" "after_app_install" {
+  triggers = {
+    app_version = "1.2.0"
+  }
+  only_if_restart_required = true
+}
@@ -0,0 +1,10 @@
+resource "scp_token" "pipeline" {
+  user       = "pipeline-svc"
+  audience   = "ci-pipeline"
+  expires_on = "+90d"
+}
+
+output "pipeline_token" {
+  value     = scp_token.pipeline.token
+  sensitive = true
+}
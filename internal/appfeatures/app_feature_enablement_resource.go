@@ -0,0 +1,194 @@
+package appfeatures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_app_feature_enablement"
+
+	schemaKeyAppGroup    = "app_group"
+	schemaKeyFeatureName = "feature_name"
+	schemaKeyEnabled     = "enabled"
+)
+
+func appFeatureEnablementResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyAppGroup: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny("/")),
+			Description:      "The app group the feature belongs to. Can not be updated after creation.",
+		},
+		schemaKeyFeatureName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny("/")),
+			Description:      "The name of the feature to toggle. Can not be updated after creation.",
+		},
+		schemaKeyEnabled: {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Whether the feature is enabled for the app group.",
+		},
+	}
+}
+
+func ResourceAppFeatureEnablement() *schema.Resource {
+	return &schema.Resource{
+		Description: "App Feature Enablement Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ACSusage " +
+			"for more latest, detailed information on attribute requirements and the ACS API.",
+
+		CreateContext: resourceAppFeatureEnablementCreate,
+		ReadContext:   resourceAppFeatureEnablementRead,
+		UpdateContext: resourceAppFeatureEnablementUpdate,
+		DeleteContext: resourceAppFeatureEnablementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAppFeatureEnablementImport,
+		},
+
+		Schema: appFeatureEnablementResourceSchema(),
+	}
+}
+
+func resourceAppFeatureEnablementCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appGroup := d.Get(schemaKeyAppGroup).(string)
+	featureName := d.Get(schemaKeyFeatureName).(string)
+	enabled := d.Get(schemaKeyEnabled).(bool)
+
+	if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitFeatureEnablementSet(ctx, acsClient, stack, appGroup, featureName, enabled)
+	}); err != nil {
+		return diag.Errorf("Error submitting request for feature (%s) of app group (%s) to be set: %s", featureName, appGroup, err)
+	}
+
+	if err := WaitFeatureEnablementVerifySet(ctx, acsClient, stack, appGroup, featureName, enabled); err != nil {
+		return diag.Errorf("Error waiting for feature (%s) of app group (%s) to be set: %s", featureName, appGroup, err)
+	}
+
+	// Set ID of feature enablement resource to appGroup/featureName to indicate the feature has been set
+	id := FeatureEnablementID(appGroup, featureName)
+	d.SetId(id)
+	tflog.Info(ctx, fmt.Sprintf("Created app feature enablement resource: %s\n", id))
+
+	// Call read to set attributes of feature enablement
+	return resourceAppFeatureEnablementRead(ctx, d, m)
+}
+
+func resourceAppFeatureEnablementRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appGroup, featureName, err := ParseFeatureEnablementID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	featureEnablement, err := WaitFeatureEnablementRead(ctx, acsClient, stack, appGroup, featureName)
+	if err != nil {
+		// if feature not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing app feature enablement from state. Not Found error while reading feature (%s) of app group (%s): %s.", featureName, appGroup, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading feature (%s) of app group (%s): %s", featureName, appGroup, err)
+	}
+
+	if err := d.Set(schemaKeyAppGroup, appGroup); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyFeatureName, featureName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyEnabled, featureEnablement.Enabled != nil && *featureEnablement.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAppFeatureEnablementUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appGroup := d.Get(schemaKeyAppGroup).(string)
+	featureName := d.Get(schemaKeyFeatureName).(string)
+
+	if d.HasChange(schemaKeyEnabled) {
+		enabled := d.Get(schemaKeyEnabled).(bool)
+
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitFeatureEnablementSet(ctx, acsClient, stack, appGroup, featureName, enabled)
+		}); err != nil {
+			return diag.Errorf("Error submitting request for feature (%s) of app group (%s) to be updated: %s", featureName, appGroup, err)
+		}
+
+		if err := WaitFeatureEnablementVerifySet(ctx, acsClient, stack, appGroup, featureName, enabled); err != nil {
+			return diag.Errorf("Error waiting for feature (%s) of app group (%s) to be updated: %s", featureName, appGroup, err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated app feature enablement resource: %s\n", d.Id()))
+	}
+
+	return resourceAppFeatureEnablementRead(ctx, d, m)
+}
+
+func resourceAppFeatureEnablementDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A feature toggle can not be removed, the feature keeps its current value and the resource is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("App feature enablement resource (%s) removed from state only. The feature keeps its current value.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func resourceAppFeatureEnablementImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	appGroup, featureName, err := ParseFeatureEnablementID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(schemaKeyAppGroup, appGroup); err != nil {
+		return nil, err
+	}
+	if err := d.Set(schemaKeyFeatureName, featureName); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// FeatureEnablementID returns the resource ID of a feature enablement in the format appGroup/featureName
+func FeatureEnablementID(appGroup string, featureName string) string {
+	return fmt.Sprintf("%s/%s", appGroup, featureName)
+}
+
+// ParseFeatureEnablementID splits a resource ID in the format appGroup/featureName into its app group and feature name
+func ParseFeatureEnablementID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected appGroup/featureName", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,27 @@
+package appfeatures_test
+
+import (
+	"testing"
+
+	"github.com/splunk/terraform-provider-scp/internal/appfeatures"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR APP FEATURE ENABLEMENT RESOURCE
+
+A feature toggle can not be unset through ACS and applies to every app of the app group, so a run would permanently
+change the behaviour of the apps installed on the acceptance test stack.
+*/
+
+func Test_ParseFeatureEnablementID(t *testing.T) {
+	appGroup, featureName, err := appfeatures.ParseFeatureEnablementID(appfeatures.FeatureEnablementID(mockAppGroup, mockFeatureName))
+	assert.NoError(t, err)
+	assert.Equal(t, mockAppGroup, appGroup)
+	assert.Equal(t, mockFeatureName, featureName)
+
+	for _, id := range []string{"", mockAppGroup, "/" + mockFeatureName, mockAppGroup + "/", "a/b/c"} {
+		_, _, err = appfeatures.ParseFeatureEnablementID(id)
+		assert.Error(t, err, id)
+	}
+}
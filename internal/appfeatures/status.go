@@ -0,0 +1,80 @@
+package appfeatures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// FeatureEnablementStatusSet returns StateRefreshFunc that makes POST request and checks if request was accepted
+func FeatureEnablementStatusSet(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string, enabled bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		setRequest := v2.SetAppFeatureEnablementJSONRequestBody{
+			Enabled: &enabled,
+		}
+		resp, err := acsClient.SetAppFeatureEnablement(ctx, stack, v2.AppGroup(appGroup), v2.FeatureName(featureName), setRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// FeatureEnablementStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the feature enablement
+func FeatureEnablementStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeAppFeatureEnablement(ctx, stack, v2.AppGroup(appGroup), v2.FeatureName(featureName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var featureEnablement v2.AppFeatureEnablement
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &featureEnablement); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &featureEnablement, status, nil
+	}
+}
+
+// FeatureEnablementStatusVerifySet returns StateRefreshFunc that makes GET request and checks if the feature enablement
+// matches the requested value
+func FeatureEnablementStatusVerifySet(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string, enabled bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := FeatureEnablementStatusRead(ctx, acsClient, stack, appGroup, featureName)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+
+		featureEnablement := output.(*v2.AppFeatureEnablement)
+		if featureEnablement.Enabled != nil && *featureEnablement.Enabled == enabled {
+			return featureEnablement, status.UpdatedStatus, nil
+		}
+		return nil, statusText, nil
+	}
+}
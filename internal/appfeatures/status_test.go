@@ -0,0 +1,101 @@
+package appfeatures_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/appfeatures"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_FeatureEnablementStatusSet(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.MatchedBy(func(body v2.SetAppFeatureEnablementJSONRequestBody) bool {
+			return body.Enabled != nil && *body.Enabled
+		})).Return(genFeatureEnablementResp(http.StatusAccepted, true), nil).Once()
+		_, statusText, err := appfeatures.FeatureEnablementStatusSet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := appfeatures.FeatureEnablementStatusSet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_FeatureEnablementStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(http.StatusOK, true), nil).Once()
+		output, statusText, err := appfeatures.FeatureEnablementStatusRead(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.True(t, *output.(*v2.AppFeatureEnablement).Enabled)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(http.StatusTooManyRequests, false), nil).Once()
+		_, statusText, err := appfeatures.FeatureEnablementStatusRead(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(http.StatusNotFound, false), nil).Once()
+		output, statusText, err := appfeatures.FeatureEnablementStatusRead(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+}
+
+func Test_FeatureEnablementStatusVerifySet(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with value applied", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(http.StatusOK, false), nil).Once()
+		_, statusText, err := appfeatures.FeatureEnablementStatusVerifySet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, false)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with value not yet applied", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(http.StatusOK, false), nil).Once()
+		output, statusText, err := appfeatures.FeatureEnablementStatusVerifySet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+}
+
+func genFeatureEnablementResp(code int, enabled bool) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(v2.AppFeatureEnablement{Enabled: &enabled})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,63 @@
+package appfeatures
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// PendingStatusVerifySet feature enablement is returned with 200 until the requested value is applied
+	PendingStatusVerifySet = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitFeatureEnablementSet Handles retry logic for POST requests for create/update lifecycle functions
+func WaitFeatureEnablementSet(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string, enabled bool) error {
+	waitFeatureEnablementSetAccepted := wait.GenerateWriteStateChangeConf(FeatureEnablementStatusSet(ctx, acsClient, stack, appGroup, featureName, enabled))
+
+	rawResp, err := waitFeatureEnablementSetAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for feature (%s) of app group (%s) to be set: %s", featureName, appGroup, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and change in progress
+	tflog.Info(ctx, fmt.Sprintf("Set response status code for feature (%s) of app group (%s): %d\n", featureName, appGroup, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for feature (%s) of app group (%s): %s\n", featureName, appGroup, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitFeatureEnablementVerifySet Handles retry logic for GET requests to verify that the feature enablement matches the requested value
+func WaitFeatureEnablementVerifySet(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string, enabled bool) error {
+	waitFeatureEnablementSet := wait.GenerateReadStateChangeConf(PendingStatusVerifySet, []string{status.UpdatedStatus}, FeatureEnablementStatusVerifySet(ctx, acsClient, stack, appGroup, featureName, enabled))
+
+	_, err := waitFeatureEnablementSet.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming feature (%s) of app group (%s) has been set: %s", featureName, appGroup, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitFeatureEnablementRead Handles retry logic for GET requests for the read lifecycle function
+func WaitFeatureEnablementRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appGroup string, featureName string) (*v2.AppFeatureEnablement, error) {
+	waitFeatureEnablementRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, FeatureEnablementStatusRead(ctx, acsClient, stack, appGroup, featureName))
+
+	output, err := waitFeatureEnablementRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading feature (%s) of app group (%s): %s", featureName, appGroup, err))
+		return nil, err
+	}
+	featureEnablement := output.(*v2.AppFeatureEnablement)
+
+	return featureEnablement, nil
+}
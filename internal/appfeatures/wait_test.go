@@ -0,0 +1,91 @@
+package appfeatures_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/appfeatures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack       = "mock-stack"
+	mockAppGroup    = "mock-app-group"
+	mockFeatureName = "mock-feature"
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitFeatureEnablementSet(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := appfeatures.WaitFeatureEnablementSet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.Anything).Return(genFeatureEnablementResp(429, true), nil).Once()
+		client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.Anything).Return(genFeatureEnablementResp(202, true), nil).Once()
+		err := appfeatures.WaitFeatureEnablementSet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("SetAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName), mock.Anything).Return(genFeatureEnablementResp(statusCode, true), nil).Once()
+				err := appfeatures.WaitFeatureEnablementSet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitFeatureEnablementVerifySet(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with value applied after retry", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(200, false), nil).Once()
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(200, true), nil).Once()
+		err := appfeatures.WaitFeatureEnablementVerifySet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(403, false), nil).Once()
+		err := appfeatures.WaitFeatureEnablementVerifySet(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName, true)
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitFeatureEnablementRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(429, false), nil).Once()
+		client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(200, true), nil).Once()
+		featureEnablement, err := appfeatures.WaitFeatureEnablementRead(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName)
+		assert.NoError(t, err)
+		assert.True(t, *featureEnablement.Enabled)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeAppFeatureEnablement", mock.Anything, v2.Stack(mockStack), v2.AppGroup(mockAppGroup), v2.FeatureName(mockFeatureName)).Return(genFeatureEnablementResp(statusCode, false), nil).Once()
+				_, err := appfeatures.WaitFeatureEnablementRead(context.TODO(), client, v2.Stack(mockStack), mockAppGroup, mockFeatureName)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
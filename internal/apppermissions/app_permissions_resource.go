@@ -0,0 +1,213 @@
+package apppermissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/roles"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	ResourceKey = "scp_app_permissions"
+
+	// AllRoles is the wildcard granting access to every role, it is not validated against the roles of the stack
+	AllRoles = "*"
+
+	schemaKeyApp   = "app"
+	schemaKeyRead  = "read"
+	schemaKeyWrite = "write"
+)
+
+func appPermissionsResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyApp: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "The name of the installed app to set permissions for. Can not be updated after creation, " +
+				"if changed in config file terraform will propose a replacement.",
+		},
+		schemaKeyRead: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Set of roles that can read the app. Use `*` to grant read access to every role.",
+		},
+		schemaKeyWrite: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Set of roles that can write to the app. Use `*` to grant write access to every role. " +
+				"If not set, no role is granted write access.",
+		},
+	}
+}
+
+func ResourceAppPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "App Permissions Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageAppPermissions " +
+			"for more latest, detailed information on attribute requirements and the ACS App Permissions API.",
+
+		CreateContext: resourceAppPermissionsCreate,
+		ReadContext:   resourceAppPermissionsRead,
+		UpdateContext: resourceAppPermissionsUpdate,
+		DeleteContext: resourceAppPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: appPermissionsResourceSchema(),
+	}
+}
+
+func resourceAppPermissionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appName := d.Get(schemaKeyApp).(string)
+	patchRequest := parseAppPermissionsRequest(d)
+
+	if diags := updateAppPermissions(ctx, acsClient, stack, appName, patchRequest); diags != nil {
+		return diags
+	}
+
+	// Set ID of app permissions resource to the app name to indicate permissions have been set
+	d.SetId(appName)
+	tflog.Info(ctx, fmt.Sprintf("Created app permissions resource: %s\n", appName))
+
+	// Call read to set attributes of app permissions
+	return resourceAppPermissionsRead(ctx, d, m)
+}
+
+func resourceAppPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appName := d.Id()
+
+	perms, err := WaitAppPermissionsRead(ctx, acsClient, stack, appName)
+	if err != nil {
+		// if app is no longer installed set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing app permissions from state. Not Found error while reading app (%s) permissions: %s.", appName, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading app (%s) permissions: %s", appName, err)
+	}
+
+	if err := d.Set(schemaKeyApp, appName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRead, perms.Read); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyWrite, perms.Write); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAppPermissionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	appName := d.Id()
+
+	if d.HasChanges(schemaKeyRead, schemaKeyWrite) {
+		patchRequest := parseAppPermissionsRequest(d)
+		if diags := updateAppPermissions(ctx, acsClient, stack, appName, patchRequest); diags != nil {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated app permissions resource: %s\n", appName))
+	}
+
+	return resourceAppPermissionsRead(ctx, d, m)
+}
+
+func resourceAppPermissionsDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// App permissions can not be removed, the app keeps its current permissions and the resource is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("App permissions resource (%s) removed from state only. The app keeps its current permissions.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// updateAppPermissions validates that the referenced roles exist, patches the app permissions and waits until the
+// patched roles are returned
+func updateAppPermissions(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) diag.Diagnostics {
+	if err := ValidateRolesExist(ctx, acsClient, stack, append(*patchRequest.Read, *patchRequest.Write...)); err != nil {
+		return diag.Errorf("Error validating roles of app (%s) permissions: %s", appName, err)
+	}
+
+	if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitAppPermissionsUpdate(ctx, acsClient, stack, appName, patchRequest)
+	}); err != nil {
+		return diag.Errorf("Error submitting request for app (%s) permissions to be updated: %s", appName, err)
+	}
+
+	if err := WaitVerifyAppPermissionsUpdate(ctx, acsClient, stack, appName, patchRequest); err != nil {
+		return diag.Errorf("Error waiting for app (%s) permissions to be updated: %s", appName, err)
+	}
+	return nil
+}
+
+// ValidateRolesExist checks that every role other than the `*` wildcard exists on the stack
+func ValidateRolesExist(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, roleNames []string) error {
+	checked := make(map[string]bool)
+	var missing []string
+	for _, roleName := range roleNames {
+		if roleName == AllRoles || checked[roleName] {
+			continue
+		}
+		checked[roleName] = true
+
+		if _, err := roles.WaitRoleRead(ctx, acsClient, stack, roleName); err != nil {
+			if errors.IsNotFoundError(err) {
+				missing = append(missing, roleName)
+				continue
+			}
+			return fmt.Errorf("error reading role (%s): %s", roleName, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("roles (%s) do not exist", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func parseAppPermissionsRequest(d *schema.ResourceData) v2.PatchPermissionsAppsJSONRequestBody {
+	read := utils.ParseSetValues(d.Get(schemaKeyRead))
+	write := utils.ParseSetValues(d.Get(schemaKeyWrite))
+
+	return v2.PatchPermissionsAppsJSONRequestBody{
+		Read:  &read,
+		Write: &write,
+	}
+}
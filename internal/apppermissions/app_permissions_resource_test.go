@@ -0,0 +1,99 @@
+package apppermissions_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR APP PERMISSIONS RESOURCE
+
+ACS can not remove app permissions, destroying the resource leaves the permissions of the app as they are. CheckDestroy
+could not verify the destroy and every run would leave changed permissions on an app of the acceptance test stack.
+*/
+
+func Test_ValidateRolesExist(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with existing roles and wildcard", func(t *testing.T) {
+		client.On("DescribeRole", mock.Anything, v2.Stack(mockStack), v2.RoleName("power")).Return(genRoleResp(http.StatusOK), nil).Once()
+		err := apppermissions.ValidateRolesExist(context.TODO(), client, v2.Stack(mockStack), []string{"*", "power", "power"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with missing roles", func(t *testing.T) {
+		client.On("DescribeRole", mock.Anything, v2.Stack(mockStack), v2.RoleName("power")).Return(genRoleResp(http.StatusOK), nil).Once()
+		client.On("DescribeRole", mock.Anything, v2.Stack(mockStack), v2.RoleName("missing-b")).Return(genRoleResp(http.StatusNotFound), nil).Once()
+		client.On("DescribeRole", mock.Anything, v2.Stack(mockStack), v2.RoleName("missing-a")).Return(genRoleResp(http.StatusNotFound), nil).Once()
+		err := apppermissions.ValidateRolesExist(context.TODO(), client, v2.Stack(mockStack), []string{"power", "missing-b", "missing-a"})
+		assert.EqualError(t, err, "roles (missing-a, missing-b) do not exist")
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribeRole", mock.Anything, v2.Stack(mockStack), v2.RoleName("power")).Return(genRoleResp(http.StatusForbidden), nil).Once()
+		err := apppermissions.ValidateRolesExist(context.TODO(), client, v2.Stack(mockStack), []string{"power"})
+		assert.Error(t, err)
+	})
+}
+
+func Test_ResourceAppPermissionsRead(t *testing.T) {
+	t.Run("with app permissions", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusOK, &mockAppPerms), nil).Once()
+
+		d := genResourceData(t)
+		diags := apppermissions.ResourceAppPermissions().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, mockApp, d.Id())
+		assert.ElementsMatch(t, mockRead, utils.ParseSetValues(d.Get("read")))
+		assert.ElementsMatch(t, mockWrite, utils.ParseSetValues(d.Get("write")))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with app not found", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusNotFound, nil), nil).Once()
+
+		d := genResourceData(t)
+		diags := apppermissions.ResourceAppPermissions().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, d.Id())
+		client.AssertExpectations(t)
+	})
+}
+
+func genResourceData(t *testing.T) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, apppermissions.ResourceAppPermissions().Schema, map[string]interface{}{
+		"app": mockApp,
+	})
+	d.SetId(mockApp)
+	return d
+}
+
+func genACSProvider(acsClient v2.ClientInterface) client.ACSProvider {
+	return client.ACSProvider{Client: &acsClient, Stack: v2.Stack(mockStack)}
+}
+
+func genRoleResp(code int) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(v2.RolesResponse{})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
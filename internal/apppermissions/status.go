@@ -0,0 +1,90 @@
+package apppermissions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// AppPermissionsStatusRead returns StateRefreshFunc that makes GET request for the permissions of an app, checks if
+// request was successful, and returns the app permissions
+func AppPermissionsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribePermissionsApps(ctx, stack, v2.AppName(appName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var appPerms v2.AppPerms
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &appPerms); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &appPerms.Perms, status, nil
+	}
+}
+
+// AppPermissionsStatusUpdate returns StateRefreshFunc that makes PATCH request and checks if request was successful
+func AppPermissionsStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.PatchPermissionsApps(ctx, stack, v2.AppName(appName), patchRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// AppPermissionsStatusVerifyUpdate returns a StateRefreshFunc that reads the app permissions and checks to see if the
+// read and write roles of the app match those in the patch request
+func AppPermissionsStatusVerifyUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := AppPermissionsStatusRead(ctx, acsClient, stack, appName)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return nil, statusText, err
+		}
+
+		perms := output.(*v2.AppPermsProperties)
+		if VerifyAppPermissionsUpdate(patchRequest, *perms) {
+			return perms, status.UpdatedStatus, nil
+		}
+		return nil, statusText, nil
+	}
+}
+
+// VerifyAppPermissionsUpdate is a helper to verify that the read and write roles in the patch request match the app permissions
+func VerifyAppPermissionsUpdate(patchRequest v2.PatchPermissionsAppsJSONRequestBody, perms v2.AppPermsProperties) bool {
+	if patchRequest.Read != nil && !utils.IsSliceEqual(patchRequest.Read, perms.Read) {
+		return false
+	}
+	if patchRequest.Write != nil && !utils.IsSliceEqual(patchRequest.Write, perms.Write) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package apppermissions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	internalErrors "github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_AppPermissionsStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusOK, &mockAppPerms), nil).Once()
+		output, statusText, err := apppermissions.AppPermissionsStatusRead(context.TODO(), client, v2.Stack(mockStack), mockApp)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockAppPerms.Perms, *output.(*v2.AppPermsProperties))
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := apppermissions.AppPermissionsStatusRead(context.TODO(), client, v2.Stack(mockStack), mockApp)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with app not found", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusNotFound, nil), nil).Once()
+		output, statusText, err := apppermissions.AppPermissionsStatusRead(context.TODO(), client, v2.Stack(mockStack), mockApp)()
+		assert.True(t, internalErrors.IsNotFoundError(err))
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusForbidden, nil), nil).Once()
+		output, statusText, err := apppermissions.AppPermissionsStatusRead(context.TODO(), client, v2.Stack(mockStack), mockApp)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusForbidden), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(nil, errors.New("some error")).Once()
+		_, _, err := apppermissions.AppPermissionsStatusRead(context.TODO(), client, v2.Stack(mockStack), mockApp)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_AppPermissionsStatusUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mockPatchRequest).Return(genAppPermsResp(http.StatusOK, nil), nil).Once()
+		_, statusText, err := apppermissions.AppPermissionsStatusUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := apppermissions.AppPermissionsStatusUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_AppPermissionsStatusVerifyUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with permissions updated", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusOK, &mockAppPerms), nil).Once()
+		_, statusText, err := apppermissions.AppPermissionsStatusVerifyUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with permissions not yet updated", func(t *testing.T) {
+		read := []string{"user"}
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusOK, &v2.AppPerms{
+			Name: mockApp, Perms: v2.AppPermsProperties{Read: &read},
+		}), nil).Once()
+		output, statusText, err := apppermissions.AppPermissionsStatusVerifyUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(http.StatusTooManyRequests, nil), nil).Once()
+		output, statusText, err := apppermissions.AppPermissionsStatusVerifyUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+}
+
+func Test_VerifyAppPermissionsUpdate(t *testing.T) {
+	read := []string{"power", "user"}
+	write := []string{"admin"}
+	otherWrite := []string{"sc_admin"}
+
+	assert.True(t, apppermissions.VerifyAppPermissionsUpdate(mockPatchRequest, v2.AppPermsProperties{Read: &read, Write: &write}))
+	assert.False(t, apppermissions.VerifyAppPermissionsUpdate(mockPatchRequest, v2.AppPermsProperties{Read: &read, Write: &otherWrite}))
+	assert.False(t, apppermissions.VerifyAppPermissionsUpdate(mockPatchRequest, v2.AppPermsProperties{Read: &read}))
+}
+
+func genAppPermsResp(code int, appPerms *v2.AppPerms) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(appPerms)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genResp(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,64 @@
+package apppermissions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// PendingStatusVerifyUpdated app permissions are read with 200 until the patched roles are returned
+	PendingStatusVerifyUpdated = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitAppPermissionsRead Handles retry logic for GET requests for the read lifecycle function
+func WaitAppPermissionsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string) (*v2.AppPermsProperties, error) {
+	waitAppPermissionsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, AppPermissionsStatusRead(ctx, acsClient, stack, appName))
+
+	output, err := waitAppPermissionsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading app (%s) permissions: %s", appName, err))
+		return nil, err
+	}
+	perms := output.(*v2.AppPermsProperties)
+
+	return perms, nil
+}
+
+// WaitAppPermissionsUpdate Handles retry logic for PATCH requests for the create/update lifecycle functions
+func WaitAppPermissionsUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) error {
+	waitAppPermissionsUpdateAccepted := wait.GenerateWriteStateChangeConf(AppPermissionsStatusUpdate(ctx, acsClient, stack, appName, patchRequest))
+
+	rawResp, err := waitAppPermissionsUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for app (%s) permissions to be updated: %s", appName, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for app (%s) permissions: %d\n", appName, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for app (%s) permissions: %s\n", appName, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitVerifyAppPermissionsUpdate Handles retry logic for GET requests to verify that the app permissions match those
+// of the patch request
+func WaitVerifyAppPermissionsUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, appName string, patchRequest v2.PatchPermissionsAppsJSONRequestBody) error {
+	waitAppPermissionsUpdated := wait.GenerateReadStateChangeConf(PendingStatusVerifyUpdated, []string{status.UpdatedStatus}, AppPermissionsStatusVerifyUpdate(ctx, acsClient, stack, appName, patchRequest))
+
+	_, err := waitAppPermissionsUpdated.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming app (%s) permissions have been updated: %s", appName, err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package apppermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+	mockApp   = "mock-app"
+)
+
+var (
+	mockRead         = []string{"user", "power"}
+	mockWrite        = []string{"admin"}
+	mockPatchRequest = v2.PatchPermissionsAppsJSONRequestBody{Read: &mockRead, Write: &mockWrite}
+
+	mockAppPerms = v2.AppPerms{Name: mockApp, Perms: v2.AppPermsProperties{Read: &mockRead, Write: &mockWrite}}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitAppPermissionsRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(429, nil), nil).Once()
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(200, &mockAppPerms), nil).Once()
+		perms, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, v2.Stack(mockStack), mockApp)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAppPerms.Perms, *perms)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(statusCode, nil), nil).Once()
+				_, err := apppermissions.WaitAppPermissionsRead(context.TODO(), client, v2.Stack(mockStack), mockApp)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitAppPermissionsUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mockPatchRequest).Return(genAppPermsResp(429, nil), nil).Once()
+		client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mockPatchRequest).Return(genAppPermsResp(200, nil), nil).Once()
+		err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PatchPermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp), mock.Anything).Return(genAppPermsResp(statusCode, nil), nil).Once()
+				err := apppermissions.WaitAppPermissionsUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyAppPermissionsUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with permissions updated after retry", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(200, &v2.AppPerms{Name: mockApp}), nil).Once()
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(200, &mockAppPerms), nil).Once()
+		err := apppermissions.WaitVerifyAppPermissionsUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("DescribePermissionsApps", mock.Anything, v2.Stack(mockStack), v2.AppName(mockApp)).Return(genAppPermsResp(403, nil), nil).Once()
+		err := apppermissions.WaitVerifyAppPermissionsUpdate(context.TODO(), client, v2.Stack(mockStack), mockApp, mockPatchRequest)
+		assert.Error(t, err)
+	})
+}
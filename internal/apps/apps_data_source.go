@@ -0,0 +1,211 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+)
+
+const (
+	DataSourceKey = "scp_apps"
+
+	schemaKeySplunkbase                 = "splunkbase"
+	schemaKeyExperience                 = "experience"
+	schemaKeyNames                      = "names"
+	schemaKeyApps                       = "apps"
+	schemaKeyName                       = "name"
+	schemaKeyVersion                    = "version"
+	schemaKeyStatus                     = "status"
+	schemaKeySplunkbaseID               = "splunkbase_id"
+	schemaKeyLabel                      = "label"
+	schemaKeyAppID                      = "app_id"
+	schemaKeyStateChangeRequiresRestart = "state_change_requires_restart"
+)
+
+func appsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeySplunkbase: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Only return apps installed from Splunkbase (true) or private apps (false). " +
+				"By default every installed app is returned.",
+		},
+		schemaKeyExperience: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{ExperienceVictoria, ExperienceClassic}, true)),
+			Description: "Valid values: (victoria | classic). The experience of the stack, selects the ACS apps API to use. " +
+				"By default the experience is read from the stack type.",
+		},
+		schemaKeyNames: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sorted names of the matching apps.",
+		},
+		schemaKeyApps: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyName: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the app.",
+					},
+					schemaKeyVersion: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The installed version of the app.",
+					},
+					schemaKeyStatus: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the app.",
+					},
+					schemaKeySplunkbaseID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Splunkbase ID of the app. Empty for private apps.",
+					},
+					schemaKeyLabel: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The label of the app.",
+					},
+					schemaKeyAppID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The app ID of the app.",
+					},
+					schemaKeyStateChangeRequiresRestart: {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "True if installing, updating or uninstalling the app requires a restart of the stack.",
+					},
+				},
+			},
+			Description: "The matching apps sorted by name.",
+		},
+	}
+}
+
+func DataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		Description: "Apps Data Source. Use this data source to list the apps installed on the stack, for example to fail a " +
+			"pipeline when an app is installed that is not managed by an `scp_private_app` or `scp_splunkbase_app` resource. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageApps " +
+			"for more latest, detailed information on attribute requirements and the ACS Apps API.",
+
+		ReadContext: dataSourceAppsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: appsDataSourceSchema(),
+	}
+}
+
+func dataSourceAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// splunkbase is only used as a filter when it is set in config, false is a valid filter value
+	var splunkbase *bool
+	if rawSplunkbase := d.GetRawConfig().GetAttr(schemaKeySplunkbase); !rawSplunkbase.IsNull() {
+		splunkbaseVal := rawSplunkbase.True()
+		splunkbase = &splunkbaseVal
+	}
+
+	experience := strings.ToLower(d.Get(schemaKeyExperience).(string))
+	if experience == "" {
+		stackStatus, err := stacks.WaitStackRead(ctx, acsClient, stack)
+		if err != nil {
+			return diag.Errorf("Error reading stack (%s) type to list apps: %s", stack, err)
+		}
+		experience = ExperienceFromStackType(stackStatus.Infrastructure.StackType)
+	}
+
+	apps, err := WaitAppsList(ctx, acsClient, stack, experience, splunkbase)
+	if err != nil {
+		return diag.Errorf("Error listing apps: %s", err)
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].Name < apps[j].Name
+	})
+
+	names := make([]string, 0, len(apps))
+	flattened := make([]interface{}, 0, len(apps))
+	for _, app := range apps {
+		names = append(names, app.Name)
+		flattened = append(flattened, FlattenApp(app))
+	}
+
+	if err := d.Set(schemaKeyExperience, experience); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyNames, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyApps, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	splunkbaseID := ""
+	if splunkbase != nil {
+		splunkbaseID = fmt.Sprintf("%t", *splunkbase)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", stack, experience, splunkbaseID))
+
+	return nil
+}
+
+// ExperienceFromStackType maps the stack type returned by ACS to the experience of the apps API, stacks of unknown
+// type are treated as Victoria stacks
+func ExperienceFromStackType(stackType *string) string {
+	if stackType != nil && strings.EqualFold(*stackType, ExperienceClassic) {
+		return ExperienceClassic
+	}
+	return ExperienceVictoria
+}
+
+// FlattenApp converts an app to the map of attributes of the apps attribute
+func FlattenApp(app v2.App) map[string]interface{} {
+	flattened := map[string]interface{}{
+		schemaKeyName:                       app.Name,
+		schemaKeyVersion:                    "",
+		schemaKeyStatus:                     app.Status,
+		schemaKeySplunkbaseID:               "",
+		schemaKeyLabel:                      "",
+		schemaKeyAppID:                      "",
+		schemaKeyStateChangeRequiresRestart: app.StateChangeRequiresRestart != nil && *app.StateChangeRequiresRestart,
+	}
+	if app.Version != nil {
+		flattened[schemaKeyVersion] = *app.Version
+	}
+	if app.SplunkbaseID != nil {
+		flattened[schemaKeySplunkbaseID] = *app.SplunkbaseID
+	}
+	if app.Label != nil {
+		flattened[schemaKeyLabel] = *app.Label
+	}
+	if app.AppID != nil {
+		flattened[schemaKeyAppID] = *app.AppID
+	}
+	return flattened
+}
@@ -0,0 +1,58 @@
+package apps_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+)
+
+const appsDataSourceTemplate = `
+data "scp_apps" "private" {
+	splunkbase = false
+}
+`
+
+func TestAcc_SplunkCloudApps_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: appsDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_apps.private", "experience"),
+					resource.TestCheckResourceAttrSet("data.scp_apps.private", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func Test_ExperienceFromStackType(t *testing.T) {
+	classic := "Classic"
+	victoria := "Victoria"
+	assert.Equal(t, apps.ExperienceClassic, apps.ExperienceFromStackType(&classic))
+	assert.Equal(t, apps.ExperienceVictoria, apps.ExperienceFromStackType(&victoria))
+	assert.Equal(t, apps.ExperienceVictoria, apps.ExperienceFromStackType(nil))
+}
+
+func Test_FlattenApp(t *testing.T) {
+	t.Run("with splunkbase app", func(t *testing.T) {
+		flattened := apps.FlattenApp(v2.App{Name: mockAppName, Status: "installed", Version: &mockVersion, SplunkbaseID: &mockSplunkbaseID})
+		assert.Equal(t, mockAppName, flattened["name"])
+		assert.Equal(t, "installed", flattened["status"])
+		assert.Equal(t, mockVersion, flattened["version"])
+		assert.Equal(t, mockSplunkbaseID, flattened["splunkbase_id"])
+	})
+
+	t.Run("with private app", func(t *testing.T) {
+		flattened := apps.FlattenApp(v2.App{Name: mockAppName})
+		assert.Equal(t, "", flattened["splunkbase_id"])
+		assert.Equal(t, "", flattened["version"])
+		assert.Equal(t, false, flattened["state_change_requires_restart"])
+	})
+}
@@ -0,0 +1,72 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+type listBody struct {
+	Apps *[]v2.App `json:"apps,omitempty"`
+}
+
+// AppsStatusListVictoria returns StateRefreshFunc that makes GET request for a page of apps installed on a Victoria stack,
+// checks if request was successful, and returns the apps of the page
+func AppsStatusListVictoria(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListAppsVictoriaParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListAppsVictoria(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return processListResponse(resp)
+	}
+}
+
+// AppsStatusListClassic returns StateRefreshFunc that makes GET request for a page of apps installed on a Classic stack,
+// checks if request was successful, and returns the apps of the page
+func AppsStatusListClassic(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListAppsParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListApps(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return processListResponse(resp)
+	}
+}
+
+func processListResponse(resp *http.Response) (any, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+		return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+			State:         http.StatusText(resp.StatusCode),
+			ExpectedState: wait.TargetStatusResourceExists,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	apps := make([]v2.App, 0)
+	if resp.StatusCode == http.StatusOK {
+		var body listBody
+		if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		if body.Apps != nil {
+			apps = *body.Apps
+		}
+	}
+	status := http.StatusText(resp.StatusCode)
+	return apps, status, nil
+}
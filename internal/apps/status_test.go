@@ -0,0 +1,89 @@
+package apps_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack   = "mock-stack"
+	mockAppName = "mock-app"
+)
+
+var (
+	mockVersion      = "1.0.0"
+	mockSplunkbaseID = "1234"
+)
+
+func Test_AppsStatusListVictoria(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("ListAppsVictoria", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genAppsResp(http.StatusOK, []v2.App{{Name: mockAppName, Status: "installed"}}), nil).Once()
+		output, statusText, err := apps.AppsStatusListVictoria(context.TODO(), client, mockStack, v2.ListAppsVictoriaParams{})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockAppName, output.([]v2.App)[0].Name)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ListAppsVictoria", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genAppsResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := apps.AppsStatusListVictoria(context.TODO(), client, mockStack, v2.ListAppsVictoriaParams{})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ListAppsVictoria", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := apps.AppsStatusListVictoria(context.TODO(), client, mockStack, v2.ListAppsVictoriaParams{})()
+		assert.Error(t, err)
+	})
+}
+
+func Test_AppsStatusListClassic(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("ListApps", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genAppsResp(http.StatusOK, []v2.App{{Name: mockAppName}}), nil).Once()
+		output, _, err := apps.AppsStatusListClassic(context.TODO(), client, mockStack, v2.ListAppsParams{})()
+		assert.NoError(t, err)
+		assert.Len(t, output.([]v2.App), 1)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("ListApps", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genAppsResp(http.StatusForbidden, nil), nil).Once()
+		output, statusText, err := apps.AppsStatusListClassic(context.TODO(), client, mockStack, v2.ListAppsParams{})()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusForbidden), statusText)
+	})
+}
+
+func genAppsResp(code int, appList []v2.App) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(map[string][]v2.App{"apps": appList})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
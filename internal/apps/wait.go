@@ -0,0 +1,50 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// AppPageSize is the number of apps requested per ListApps/ListAppsVictoria call
+	AppPageSize = 100
+
+	ExperienceVictoria = "victoria"
+	ExperienceClassic  = "classic"
+)
+
+// WaitAppsList Handles retry logic for GET requests listing installed apps, pages through every app of the stack using
+// the Victoria or Classic apps API depending on the experience. A nil splunkbase lists every app.
+func WaitAppsList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, experience string, splunkbase *bool) ([]v2.App, error) {
+	count := v2.Count(AppPageSize)
+
+	apps := make([]v2.App, 0)
+	for offset := v2.Offset(0); ; offset += AppPageSize {
+		pageOffset := offset
+
+		var statusFunc resource.StateRefreshFunc
+		if experience == ExperienceClassic {
+			statusFunc = AppsStatusListClassic(ctx, acsClient, stack, v2.ListAppsParams{Count: &count, Offset: &pageOffset, Splunkbase: splunkbase})
+		} else {
+			statusFunc = AppsStatusListVictoria(ctx, acsClient, stack, v2.ListAppsVictoriaParams{Count: &count, Offset: &pageOffset, Splunkbase: splunkbase})
+		}
+		waitAppsList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, statusFunc)
+
+		output, err := waitAppsList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing apps at offset (%d): %s", offset, err))
+			return nil, err
+		}
+		page := output.([]v2.App)
+		apps = append(apps, page...)
+
+		if len(page) < AppPageSize {
+			return apps, nil
+		}
+	}
+}
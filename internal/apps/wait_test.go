@@ -0,0 +1,52 @@
+package apps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var unexpectedStatusCodes = []int{400, 401, 403, 404, 409, 501, 503}
+
+func Test_WaitAppsList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with victoria experience and splunkbase filter", func(t *testing.T) {
+		client.On("ListAppsVictoria", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListAppsVictoriaParams) bool {
+			return params != nil && params.Splunkbase != nil && *params.Splunkbase
+		})).Return(genAppsResp(200, []v2.App{{Name: mockAppName, SplunkbaseID: &mockSplunkbaseID}}), nil).Once()
+		splunkbase := true
+		appList, err := apps.WaitAppsList(context.TODO(), client, mockStack, apps.ExperienceVictoria, &splunkbase)
+		assert.NoError(t, err)
+		assert.Len(t, appList, 1)
+	})
+
+	t.Run("with classic experience and multiple pages", func(t *testing.T) {
+		client.On("ListApps", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListAppsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0 && params.Splunkbase == nil
+		})).Return(genAppsResp(200, make([]v2.App, apps.AppPageSize)), nil).Once()
+		client.On("ListApps", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListAppsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == apps.AppPageSize
+		})).Return(genAppsResp(200, []v2.App{{Name: mockAppName}}), nil).Once()
+		appList, err := apps.WaitAppsList(context.TODO(), client, mockStack, apps.ExperienceClassic, nil)
+		assert.NoError(t, err)
+		assert.Len(t, appList, apps.AppPageSize+1)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("ListAppsVictoria", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genAppsResp(code, nil), nil).Once()
+				appList, err := apps.WaitAppsList(context.TODO(), client, mockStack, apps.ExperienceVictoria, nil)
+				assert.Error(t, err)
+				assert.Nil(t, appList)
+			})
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package deployments
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKey = "scp_last_deployment"
+
+	schemaKeyID        = "id"
+	schemaKeyStatus    = "status"
+	schemaKeyTimestamp = "timestamp"
+)
+
+func lastDeploymentDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the last deployment task. Set to the stack name if the stack has no deployment task.",
+		},
+		schemaKeyStatus: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The status of the last deployment task, possible values are new, pending, running, completed and failed. " +
+				"Create, update and delete requests are rejected while the last deployment task has failed, the provider retries the " +
+				"failed task before resubmitting such requests.",
+		},
+		schemaKeyTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The timestamp of the last deployment task.",
+		},
+	}
+}
+
+func DataSourceLastDeployment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Last Deployment Data Source. Use this data source to read the last deployment task of the stack, for example " +
+			"to check that no deployment task has failed before applying changes. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ACSerrormessages " +
+			"for more latest, detailed information on failed deployment tasks.",
+
+		ReadContext: dataSourceLastDeploymentRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: lastDeploymentDataSourceSchema(),
+	}
+}
+
+func dataSourceLastDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	deployment, err := WaitLastDeploymentRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading last deployment task: %s", err)
+	}
+
+	for key, value := range FlattenDeployment(*deployment) {
+		if key == schemaKeyID {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// a stack without any deployment task has no deployment ID, the stack name keeps the data source ID non-empty
+	if deployment.Id == "" {
+		d.SetId(string(stack))
+	} else {
+		d.SetId(deployment.Id)
+	}
+
+	return nil
+}
+
+// FlattenDeployment converts a deployment task to the map of attributes of the last deployment data source
+func FlattenDeployment(deployment v2.DeploymentInfo) map[string]interface{} {
+	flattened := map[string]interface{}{
+		schemaKeyID:        deployment.Id,
+		schemaKeyStatus:    "",
+		schemaKeyTimestamp: "",
+	}
+	if deployment.Status != nil {
+		flattened[schemaKeyStatus] = *deployment.Status
+	}
+	if deployment.Timestamp != nil {
+		flattened[schemaKeyTimestamp] = *deployment.Timestamp
+	}
+	return flattened
+}
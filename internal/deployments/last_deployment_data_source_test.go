@@ -0,0 +1,48 @@
+package deployments_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/stretchr/testify/assert"
+)
+
+const lastDeploymentDataSourceTemplate = `
+data "scp_last_deployment" "current" {}
+`
+
+func TestAcc_SplunkCloudLastDeployment_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: lastDeploymentDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_last_deployment.current", "id"),
+					resource.TestCheckResourceAttrSet("data.scp_last_deployment.current", "status"),
+				),
+			},
+		},
+	})
+}
+
+func Test_FlattenDeployment(t *testing.T) {
+	t.Run("with complete deployment", func(t *testing.T) {
+		status := deployments.TaskStatusSucceeded
+		timestamp := mockTimestamp
+		flattened := deployments.FlattenDeployment(v2.DeploymentInfo{Id: mockDeploymentID, Status: &status, Timestamp: &timestamp})
+		assert.Equal(t, mockDeploymentID, flattened["id"])
+		assert.Equal(t, status, flattened["status"])
+		assert.Equal(t, timestamp, flattened["timestamp"])
+	})
+
+	t.Run("with missing status and timestamp", func(t *testing.T) {
+		flattened := deployments.FlattenDeployment(v2.DeploymentInfo{Id: mockDeploymentID})
+		assert.Equal(t, "", flattened["status"])
+		assert.Equal(t, "", flattened["timestamp"])
+	})
+}
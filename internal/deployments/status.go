@@ -0,0 +1,126 @@
+package deployments
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// listBody accepts the deployment status both with and without the status envelope of the generated client
+type listBody struct {
+	Status *v2.DeploymentStatus `json:"status,omitempty"`
+}
+
+// StatusLastDeployment returns StateRefreshFunc that makes GET request and checks if request was successful. If the request
+// was successful, we return the last deployment task of the stack
+func StatusLastDeployment(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListDeployment(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), nil
+		}
+
+		var deploymentStatus v2.DeploymentStatus
+		if err = json.Unmarshal(bodyBytes, &deploymentStatus); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		if deploymentStatus.LastDeployment.Id == "" {
+			var body listBody
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.Status != nil {
+				deploymentStatus = *body.Status
+			}
+		}
+		return &deploymentStatus.LastDeployment, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// StatusRetryTaskComplete returns StateRefreshFunc that makes GET request and checks if request was successful. If the request was successful, we return
+// deployment info to access status
+func StatusRetryTaskComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, deploymentID string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeDeployment(ctx, stack, v2.DeploymentID(deploymentID))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+
+		defer resp.Body.Close()
+
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{LastError: errors.New(string(bodyBytes))}
+		}
+
+		var deploymentInfo v2.DeploymentInfo
+		statusText := http.StatusText(resp.StatusCode)
+
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &deploymentInfo); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if deploymentInfo.Status != nil {
+				statusText = *deploymentInfo.Status
+			}
+			return &deploymentInfo, statusText, nil
+		}
+		return nil, statusText, nil
+	}
+}
+
+// StatusRetryTask returns StateRefreshFunc that makes POST request and checks if request was accepted. If the request
+// was accepted, we return deployment info of the retried task
+func StatusRetryTask(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.RetryDeployment(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		// restore the body so the error returned by ProcessResponse contains the ACS error message
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		_, statusText, statusErr := status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+
+		var deploymentInfo v2.DeploymentInfo
+
+		if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &deploymentInfo); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			return &deploymentInfo, statusText, statusErr
+		}
+
+		return nil, statusText, statusErr
+	}
+}
@@ -0,0 +1,144 @@
+package deployments_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack        = "mock-stack"
+	mockDeploymentID = "mock-id"
+	mockTimestamp    = "2024-01-01T00:00:00Z"
+)
+
+func genDeploymentInfoResp(code int, status string) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(&v2.DeploymentInfo{
+			Id:     mockDeploymentID,
+			Status: &status,
+		})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genDeploymentStatusResp(code int, body interface{}) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(body)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genResp(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
+
+func Test_StatusLastDeployment(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	status := deployments.TaskStatusFailed
+	timestamp := mockTimestamp
+	lastDeployment := v2.DeploymentInfo{Id: mockDeploymentID, Status: &status, Timestamp: &timestamp}
+
+	t.Run("with client error", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		_, _, err := deployments.StatusLastDeployment(context.TODO(), client, mockStack)()
+		assert.Error(t, err)
+	})
+
+	t.Run("with deployment status body", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusOK,
+			v2.DeploymentStatus{LastDeployment: lastDeployment}), nil).Once()
+		output, state, err := deployments.StatusLastDeployment(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+		assert.Equal(t, lastDeployment, *output.(*v2.DeploymentInfo))
+	})
+
+	t.Run("with status envelope body", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusOK,
+			map[string]interface{}{"status": v2.DeploymentStatus{LastDeployment: lastDeployment}}), nil).Once()
+		output, _, err := deployments.StatusLastDeployment(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Equal(t, lastDeployment, *output.(*v2.DeploymentInfo))
+	})
+
+	t.Run("with rate limit", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusTooManyRequests, nil), nil).Once()
+		output, state, err := deployments.StatusLastDeployment(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), state)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusBadRequest, nil), nil).Once()
+		_, state, err := deployments.StatusLastDeployment(context.TODO(), client, mockStack)()
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), state)
+	})
+}
+
+func Test_StatusRetryTaskComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with running task", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusRunning), nil).Once()
+		output, state, err := deployments.StatusRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)()
+		assert.NoError(t, err)
+		assert.Equal(t, deployments.TaskStatusRunning, state)
+		assert.Equal(t, mockDeploymentID, output.(*v2.DeploymentInfo).Id)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusNotFound, ""), nil).Once()
+		_, _, err := deployments.StatusRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_StatusRetryTask(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with accepted response", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		output, state, err := deployments.StatusRetryTask(context.TODO(), client, mockStack)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), state)
+		assert.Equal(t, mockDeploymentID, output.(*v2.DeploymentInfo).Id)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusBadRequest, ""), nil).Once()
+		output, _, err := deployments.StatusRetryTask(context.TODO(), client, mockStack)()
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, http.StatusText(http.StatusBadRequest))
+		assert.Nil(t, output)
+	})
+}
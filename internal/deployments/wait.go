@@ -0,0 +1,94 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	TaskStatusFailed    = "failed"
+	TaskStatusSucceeded = "completed"
+	TaskStatusNew       = "new"
+	TaskStatusPending   = "pending"
+	TaskStatusRunning   = "running"
+
+	DeploymentTaskFailedErr = "retry of deployment task %s resulted in failed status upon completion"
+)
+
+// WaitLastDeploymentRead Handles retry logic for GET requests reading the last deployment task of the stack
+func WaitLastDeploymentRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.DeploymentInfo, error) {
+	waitLastDeploymentRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, StatusLastDeployment(ctx, acsClient, stack))
+
+	output, err := waitLastDeploymentRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading last deployment task: %s", err))
+		return nil, err
+	}
+
+	return output.(*v2.DeploymentInfo), nil
+}
+
+// WaitRetryTaskComplete Handles retry logic for GET requests to check status of deployment task until completion
+func WaitRetryTaskComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, deploymentID string) error {
+	pendingState := []string{http.StatusText(http.StatusTooManyRequests), TaskStatusRunning, TaskStatusNew, TaskStatusPending}
+	targetState := []string{TaskStatusFailed, TaskStatusSucceeded}
+	waitRetryTaskComplete := wait.GenerateReadStateChangeConf(pendingState, targetState, StatusRetryTaskComplete(ctx, acsClient, stack, deploymentID))
+
+	output, err := waitRetryTaskComplete.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error checking status of deployment (%s): %s", deploymentID, err))
+		return err
+	}
+
+	deploymentInfo := output.(*v2.DeploymentInfo)
+
+	if *deploymentInfo.Status == TaskStatusFailed {
+		tflog.Error(ctx, fmt.Sprintf("retry of deployment task %s failed", deploymentID))
+		return fmt.Errorf(DeploymentTaskFailedErr, deploymentID)
+	}
+	return nil
+}
+
+// WaitRetryTask Handles retry logic for retrying a previously failed deployment task and polls the retried task until completion
+func WaitRetryTask(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) error {
+	// Retry last deployment task
+	waitRetryTaskAccepted := wait.GenerateWriteStateChangeConf(StatusRetryTask(ctx, acsClient, stack))
+	output, err := waitRetryTaskAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error retrying previous task: %s \n", err))
+		return err
+	}
+
+	deploymentInfo := output.(*v2.DeploymentInfo)
+
+	tflog.Info(ctx, fmt.Sprintf("Retry task deployment id: %s\n", deploymentInfo.Id))
+
+	// Poll retry task status until completion
+	return WaitRetryTaskComplete(ctx, acsClient, stack, deploymentInfo.Id)
+}
+
+// WaitRetryOnFailedTask runs the write request and, if it is rejected because the previous deployment task of the stack
+// failed, retries the failed task and resubmits the write request once. Any other error of the write request is returned
+// unchanged so callers can keep checking it with the errors package
+func WaitRetryOnFailedTask(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, write func() error) error {
+	err := write()
+	if !errors.IsFailedDeploymentTaskError(err) {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Retrying failed deployment task: %s.", err))
+
+	// Retry last deployment task
+	if err = WaitRetryTask(ctx, acsClient, stack); err != nil {
+		return fmt.Errorf("error retrying previous deployment task: %w", err)
+	}
+
+	// Resubmit write request
+	return write()
+}
@@ -0,0 +1,175 @@
+package deployments_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	scpErrors "github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var unexpectedStatusCodes = []int{400, 401, 403, 404, 409, 501, 503}
+
+func Test_WaitLastDeploymentRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	status := deployments.TaskStatusSucceeded
+
+	t.Run("with retry on rate limit", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusTooManyRequests, nil), nil).Once()
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusOK,
+			v2.DeploymentStatus{LastDeployment: v2.DeploymentInfo{Id: mockDeploymentID, Status: &status}}), nil).Once()
+		deployment, err := deployments.WaitLastDeploymentRead(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, mockDeploymentID, deployment.Id)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("ListDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentStatusResp(http.StatusForbidden, nil), nil).Once()
+		deployment, err := deployments.WaitLastDeploymentRead(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, deployment)
+	})
+}
+
+func Test_WaitRetryTask(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		err := deployments.WaitRetryTask(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retry task successful", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusSucceeded), nil).Once()
+		err := deployments.WaitRetryTask(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with deployment task failed", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusFailed), nil).Once()
+		err := deployments.WaitRetryTask(context.TODO(), client, mockStack)
+		assert.ErrorContains(t, err, fmt.Sprintf(deployments.DeploymentTaskFailedErr, mockDeploymentID))
+	})
+
+	t.Run("with retry on rate limit", func(t *testing.T) {
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusSucceeded), nil).Once()
+		err := deployments.WaitRetryTask(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected error resp", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected response %v", code), func(t *testing.T) {
+				client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(code, ""), nil).Once()
+				err := deployments.WaitRetryTask(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitRetryTaskComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(nil, errors.New("some error")).Once()
+		err := deployments.WaitRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retry task successful", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusSucceeded), nil).Once()
+		err := deployments.WaitRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with deployment task failed", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusFailed), nil).Once()
+		err := deployments.WaitRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+		assert.ErrorContains(t, err, fmt.Sprintf(deployments.DeploymentTaskFailedErr, mockDeploymentID))
+	})
+
+	t.Run("with retry on rate limit and running task", func(t *testing.T) {
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusRunning), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusSucceeded), nil).Once()
+		err := deployments.WaitRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected error resp", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected response %v", code), func(t *testing.T) {
+				client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(code, ""), nil).Once()
+				err := deployments.WaitRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitRetryOnFailedTask(t *testing.T) {
+	failedTaskErr := &resource.UnexpectedStateError{LastError: errors.New(scpErrors.FailedDeploymentTaskErr)}
+
+	t.Run("with successful write", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		calls := 0
+		err := deployments.WaitRetryOnFailedTask(context.TODO(), client, mockStack, func() error {
+			calls++
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("with other write error", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		writeErr := &resource.UnexpectedStateError{State: http.StatusText(http.StatusConflict), LastError: errors.New("conflict")}
+		err := deployments.WaitRetryOnFailedTask(context.TODO(), client, mockStack, func() error {
+			return writeErr
+		})
+		assert.Equal(t, writeErr, err)
+	})
+
+	t.Run("with failed task retried and write resubmitted", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusSucceeded), nil).Once()
+		calls := 0
+		err := deployments.WaitRetryOnFailedTask(context.TODO(), client, mockStack, func() error {
+			calls++
+			if calls == 1 {
+				return failedTaskErr
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("with failed retry", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(genDeploymentInfoResp(http.StatusAccepted, deployments.TaskStatusNew), nil).Once()
+		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(genDeploymentInfoResp(http.StatusOK, deployments.TaskStatusFailed), nil).Once()
+		calls := 0
+		err := deployments.WaitRetryOnFailedTask(context.TODO(), client, mockStack, func() error {
+			calls++
+			return failedTaskErr
+		})
+		assert.ErrorContains(t, err, fmt.Sprintf(deployments.DeploymentTaskFailedErr, mockDeploymentID))
+		assert.Equal(t, 1, calls)
+	})
+}
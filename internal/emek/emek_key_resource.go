@@ -0,0 +1,163 @@
+package emek
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+)
+
+const (
+	ResourceKey = "scp_emek_key"
+
+	schemaKeyKeyARN        = "key_arn"
+	schemaKeyLastRotatedAt = "last_rotated_at"
+	schemaKeyWaiver        = "waiver"
+)
+
+var keyARNRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:kms:[a-z0-9-]+:\d{12}:key/.+$`)
+
+func emekKeyResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyKeyARN: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(keyARNRegexp, "key_arn must be the ARN of a KMS key")),
+			Description: "The ARN of the KMS key to use as the customer managed encryption key of the stack. " +
+				"Changing the ARN rotates the key.",
+		},
+		schemaKeyLastRotatedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the current key was uploaded through Terraform.",
+		},
+		schemaKeyWaiver: {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The EMEK waiver status of the stack as returned by ACS. Values that are not strings are JSON encoded.",
+		},
+	}
+}
+
+func ResourceEmekKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "EMEK Key Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Security/ConfigureEMEK " +
+			"for more latest, detailed information on attribute requirements and the ACS EMEK API.",
+
+		CreateContext: resourceEmekKeyCreate,
+		ReadContext:   resourceEmekKeyRead,
+		UpdateContext: resourceEmekKeyUpdate,
+		DeleteContext: resourceEmekKeyDelete,
+
+		Schema: emekKeyResourceSchema(),
+	}
+}
+
+func resourceEmekKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	keyARN := d.Get(schemaKeyKeyARN).(string)
+
+	if diags := uploadEmekKey(ctx, d, acsClient, stack, keyARN); diags != nil {
+		return diags
+	}
+
+	// The EMEK key is a single setting per stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created EMEK key resource: %s\n", keyARN))
+
+	return resourceEmekKeyRead(ctx, d, m)
+}
+
+func resourceEmekKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// ACS does not return the uploaded key ARN, only the waiver status is refreshed
+	waiver, err := WaitEmekWaiverRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading EMEK waiver: %s", err)
+	}
+
+	flattenedWaiver, err := FlattenWaiver(waiver)
+	if err != nil {
+		return diag.Errorf("Error encoding EMEK waiver: %s", err)
+	}
+
+	if err := d.Set(schemaKeyWaiver, flattenedWaiver); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceEmekKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyKeyARN) {
+		oldKeyARN, newKeyARN := d.GetChange(schemaKeyKeyARN)
+		if diags := uploadEmekKey(ctx, d, acsClient, stack, newKeyARN.(string)); diags != nil {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated EMEK key resource from %s to %s\n", oldKeyARN, newKeyARN))
+	}
+
+	return resourceEmekKeyRead(ctx, d, m)
+}
+
+func resourceEmekKeyDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support removing the EMEK key, the resource is only removed from terraform state
+	tflog.Warn(ctx, fmt.Sprintf("EMEK key resource (%s) removed from state only. ACS does not support removing the EMEK key, "+
+		"the stack keeps using the current key.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// uploadEmekKey uploads the key and records the time of the upload so that rotations show up in the state history
+func uploadEmekKey(ctx context.Context, d *schema.ResourceData, acsClient v2.ClientInterface, stack v2.Stack, keyARN string) diag.Diagnostics {
+	if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitEmekKeyPut(ctx, acsClient, stack, keyARN)
+	}); err != nil {
+		return diag.Errorf("Error submitting request for EMEK key (%s) to be uploaded: %s", keyARN, err)
+	}
+
+	if err := d.Set(schemaKeyLastRotatedAt, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// FlattenWaiver converts the waiver returned by ACS to a map of strings, values that are not strings are JSON encoded
+func FlattenWaiver(waiver map[string]interface{}) (map[string]string, error) {
+	flattened := make(map[string]string, len(waiver))
+	for key, value := range waiver {
+		if stringValue, ok := value.(string); ok {
+			flattened[key] = stringValue
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		flattened[key] = string(encoded)
+	}
+	return flattened, nil
+}
@@ -0,0 +1,29 @@
+package emek_test
+
+import (
+	"testing"
+
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR EMEK KEY RESOURCE
+
+Uploading an EMEK key requires a KMS key in a customer AWS account with the key policy of the stack applied, which the
+acceptance test environment does not provide, and ACS can not remove the key once the stack uses it.
+*/
+
+func Test_FlattenWaiver(t *testing.T) {
+	flattened, err := emek.FlattenWaiver(map[string]interface{}{
+		"status":  "approved",
+		"expired": false,
+		"details": map[string]interface{}{"ticket": "CS-1234"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"status":  "approved",
+		"expired": "false",
+		"details": `{"ticket":"CS-1234"}`,
+	}, flattened)
+}
@@ -0,0 +1,97 @@
+package emek
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyPolicy = "scp_emek_policy"
+
+	schemaKeyEmekLegalAck = "emek_legal_ack"
+	schemaKeyPolicy       = "policy"
+	schemaKeyRegion       = "region"
+	schemaKeyMessage      = "message"
+)
+
+func policyDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyEmekLegalAck: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Y"}, false)),
+			Description: "Set to `Y` to acknowledge the legal disclaimer for customer managed encryption keys, " +
+				"which is required to generate the key policy.",
+		},
+		schemaKeyPolicy: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON encoded KMS key policy that grants the stack access to the key.",
+		},
+		schemaKeyRegion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The region of the stack, the KMS key must be created in this region.",
+		},
+		schemaKeyMessage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The message returned with the key policy.",
+		},
+	}
+}
+
+func DataSourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "EMEK Policy Data Source. Use this data source to retrieve the KMS key policy that must be applied to the " +
+			"customer managed encryption key before it is uploaded with the `scp_emek_key` resource. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Security/ConfigureEMEK " +
+			"for more latest, detailed information on attribute requirements and the ACS EMEK API.",
+
+		ReadContext: dataSourcePolicyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: policyDataSourceSchema(),
+	}
+}
+
+func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	policy, err := WaitEmekPolicyRead(ctx, acsClient, stack, d.Get(schemaKeyEmekLegalAck).(string))
+	if err != nil {
+		return diag.Errorf("Error reading EMEK key policy: %s", err)
+	}
+
+	policyJSON, err := json.Marshal(policy.Policy)
+	if err != nil {
+		return diag.Errorf("Error encoding EMEK key policy: %s", err)
+	}
+
+	if err := d.Set(schemaKeyPolicy, string(policyJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRegion, policy.Region); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyMessage, policy.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
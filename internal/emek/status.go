@@ -0,0 +1,80 @@
+package emek
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// EmekKeyStatusPut returns StateRefreshFunc that makes PUT request and checks if request was accepted
+func EmekKeyStatusPut(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, keyARN string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.PutEmekKey(ctx, stack, v2.PutEmekKeyJSONRequestBody{KeyARN: keyARN})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// EmekPolicyStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the key policy
+func EmekPolicyStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, legalAck string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetEmekPolicy(ctx, stack, &v2.GetEmekPolicyParams{EMEKLegalAck: legalAck})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var policy v2.EmekPolicy
+		return processReadResponse(resp, &policy)
+	}
+}
+
+// EmekWaiverStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the waiver
+func EmekWaiverStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeEmekWaiver(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		// the waiver has no schema in the ACS spec, so it is returned as a generic JSON object
+		waiver := make(map[string]interface{})
+		return processReadResponse(resp, &waiver)
+	}
+}
+
+// processReadResponse unmarshals a successful GET response into output, treating GeneralRetryableStatusCodes as pending
+func processReadResponse(resp *http.Response, output any) (any, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+		return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+			State:         http.StatusText(resp.StatusCode),
+			ExpectedState: wait.TargetStatusResourceExists,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(bodyBytes, output); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+	}
+	return output, http.StatusText(resp.StatusCode), nil
+}
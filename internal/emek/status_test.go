@@ -0,0 +1,97 @@
+package emek_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_EmekKeyStatusPut(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), v2.PutEmekKeyJSONRequestBody{KeyARN: mockKeyARN}).Return(genEmekResp(http.StatusAccepted, v2.EmekKeyUploadResponse{Message: "accepted"}), nil).Once()
+		_, statusText, err := emek.EmekKeyStatusPut(context.TODO(), client, v2.Stack(mockStack), mockKeyARN)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := emek.EmekKeyStatusPut(context.TODO(), client, v2.Stack(mockStack), mockKeyARN)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_EmekPolicyStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), &v2.GetEmekPolicyParams{EMEKLegalAck: mockLegalAck}).Return(genEmekResp(http.StatusOK, mockPolicy), nil).Once()
+		output, statusText, err := emek.EmekPolicyStatusRead(context.TODO(), client, v2.Stack(mockStack), mockLegalAck)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockPolicy.Region, output.(*v2.EmekPolicy).Region)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := emek.EmekPolicyStatusRead(context.TODO(), client, v2.Stack(mockStack), mockLegalAck)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(http.StatusBadRequest, nil), nil).Once()
+		output, statusText, err := emek.EmekPolicyStatusRead(context.TODO(), client, v2.Stack(mockStack), mockLegalAck)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), statusText)
+	})
+}
+
+func Test_EmekWaiverStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genEmekResp(http.StatusOK, mockWaiver), nil).Once()
+		output, statusText, err := emek.EmekWaiverStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, "approved", (*output.(*map[string]interface{}))["status"])
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		_, _, err := emek.EmekWaiverStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+	})
+}
+
+func genEmekResp(code int, body interface{}) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(body)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
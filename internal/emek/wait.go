@@ -0,0 +1,58 @@
+package emek
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// WaitEmekKeyPut Handles retry logic for PUT requests for the create/update lifecycle functions
+func WaitEmekKeyPut(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, keyARN string) error {
+	waitEmekKeyPutAccepted := wait.GenerateWriteStateChangeConf(EmekKeyStatusPut(ctx, acsClient, stack, keyARN))
+
+	rawResp, err := waitEmekKeyPutAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for EMEK key (%s) to be uploaded: %s", keyARN, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and upload in progress
+	tflog.Info(ctx, fmt.Sprintf("Upload response status code for EMEK key (%s): %d\n", keyARN, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for EMEK key (%s): %s\n", keyARN, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitEmekPolicyRead Handles retry logic for GET requests for the key policy data source
+func WaitEmekPolicyRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, legalAck string) (*v2.EmekPolicy, error) {
+	waitEmekPolicyRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, EmekPolicyStatusRead(ctx, acsClient, stack, legalAck))
+
+	output, err := waitEmekPolicyRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading EMEK key policy: %s", err))
+		return nil, err
+	}
+	policy := output.(*v2.EmekPolicy)
+
+	return policy, nil
+}
+
+// WaitEmekWaiverRead Handles retry logic for GET requests for the waiver of the read lifecycle function
+func WaitEmekWaiverRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (map[string]interface{}, error) {
+	waitEmekWaiverRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, EmekWaiverStatusRead(ctx, acsClient, stack))
+
+	output, err := waitEmekWaiverRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading EMEK waiver: %s", err))
+		return nil, err
+	}
+	waiver := *output.(*map[string]interface{})
+
+	return waiver, nil
+}
@@ -0,0 +1,101 @@
+package emek_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/emek"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack    = "mock-stack"
+	mockKeyARN   = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	mockLegalAck = "Y"
+)
+
+var (
+	mockPolicy = v2.EmekPolicy{
+		Message: "key policy generated",
+		Region:  "us-east-1",
+		Policy:  map[string]interface{}{"Version": "2012-10-17"},
+	}
+	mockWaiver = map[string]interface{}{"status": "approved", "expired": false}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitEmekKeyPut(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := emek.WaitEmekKeyPut(context.TODO(), client, v2.Stack(mockStack), mockKeyARN)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), v2.PutEmekKeyJSONRequestBody{KeyARN: mockKeyARN}).Return(genEmekResp(429, nil), nil).Once()
+		client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), v2.PutEmekKeyJSONRequestBody{KeyARN: mockKeyARN}).Return(genEmekResp(202, v2.EmekKeyUploadResponse{}), nil).Once()
+		err := emek.WaitEmekKeyPut(context.TODO(), client, v2.Stack(mockStack), mockKeyARN)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PutEmekKey", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(statusCode, nil), nil).Once()
+				err := emek.WaitEmekKeyPut(context.TODO(), client, v2.Stack(mockStack), mockKeyARN)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitEmekPolicyRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(429, nil), nil).Once()
+		client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(200, mockPolicy), nil).Once()
+		policy, err := emek.WaitEmekPolicyRead(context.TODO(), client, v2.Stack(mockStack), mockLegalAck)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPolicy.Policy, policy.Policy)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetEmekPolicy", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEmekResp(statusCode, nil), nil).Once()
+				_, err := emek.WaitEmekPolicyRead(context.TODO(), client, v2.Stack(mockStack), mockLegalAck)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitEmekWaiverRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genEmekResp(429, nil), nil).Once()
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genEmekResp(200, mockWaiver), nil).Once()
+		waiver, err := emek.WaitEmekWaiverRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.Equal(t, mockWaiver, waiver)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("DescribeEmekWaiver", mock.Anything, v2.Stack(mockStack)).Return(genEmekResp(403, nil), nil).Once()
+		_, err := emek.WaitEmekWaiverRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.Error(t, err)
+	})
+}
@@ -39,3 +39,12 @@ func IsConflictError(err error) bool {
 		return false
 	}
 }
+
+func IsNotFoundError(err error) bool {
+	switch v := err.(type) {
+	case *resource.UnexpectedStateError:
+		return v.State == http.StatusText(http.StatusNotFound)
+	default:
+		return false
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/status"
 	"github.com/splunk/terraform-provider-scp/internal/wait"
@@ -135,29 +136,14 @@ func resourceHecTokenCreate(ctx context.Context, d *schema.ResourceData, m inter
 	tflog.Info(ctx, fmt.Sprintf("%+v\n", createHecRequest))
 
 	// Create Hec Token
-	err := WaitHecCreate(ctx, acsClient, stack, createHecRequest)
+	// If previous deployment task failed, the task is retried and the request resubmitted
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitHecCreate(ctx, acsClient, stack, createHecRequest)
+	})
 	if err != nil {
 		if errors.IsConflictError(err) {
 			return diag.Errorf("Hec (%s) %s", hecName, errors.ResourceExistsErr)
 		}
-
-		// If previous deployment task failed, we retry task
-		if errors.IsFailedDeploymentTaskError(err) {
-			tflog.Info(ctx, fmt.Sprintf("Retrying failed deployment task: %s.", err))
-
-			// Retry last deployment task
-			err = WaitHecRetryTask(ctx, acsClient, stack)
-			if err != nil {
-				return diag.Errorf("Error retrying previous task, hec (%s) can't be created: %s", hecName, err)
-			}
-
-			// Resubmit request for HEC Create
-			err = WaitHecCreate(ctx, acsClient, stack, createHecRequest)
-			if err != nil {
-				return diag.Errorf("Error submitting request for hec (%s) to be created after retrying failed task: %s", hecName, err)
-			}
-		}
-
 		return diag.Errorf("Error submitting request for hec (%s) to be created: %s", hecName, err)
 	}
 
@@ -248,24 +234,11 @@ func resourceHecTokenUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	hecRequest := parseHecRequest(d)
 	patchRequest := setPatchRequestBody(d, hecRequest)
 
-	err := WaitHecUpdate(ctx, acsClient, stack, *patchRequest, hecName)
+	// If previous deployment task failed, the task is retried and the request resubmitted
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitHecUpdate(ctx, acsClient, stack, *patchRequest, hecName)
+	})
 	if err != nil {
-		// If previous deployment task failed, we retry task
-		if errors.IsFailedDeploymentTaskError(err) {
-			tflog.Info(ctx, fmt.Sprintf("Retrying failed deployment task: %s.", err))
-
-			// Retry last deployment task
-			err = WaitHecRetryTask(ctx, acsClient, stack)
-			if err != nil {
-				return diag.Errorf("Error retrying previous task, hec (%s) can't be updated: %s", hecName, err)
-			}
-
-			// Resubmit request for HEC Update
-			err = WaitHecUpdate(ctx, acsClient, stack, *patchRequest, hecName)
-			if err != nil {
-				return diag.Errorf("Error submitting request for hec (%s) to be updated after retrying failed task: %s", hecName, err)
-			}
-		}
 		return diag.Errorf("Error submitting request for hec (%s) to be updated: %s", hecName, err)
 	}
 
@@ -287,24 +260,11 @@ func resourceHecTokenDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	hecName := d.Id()
 
-	err := WaitHecDelete(ctx, acsClient, stack, hecName)
+	// If previous deployment task failed, the task is retried and the request resubmitted
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitHecDelete(ctx, acsClient, stack, hecName)
+	})
 	if err != nil {
-		// If previous deployment task failed, we retry task
-		if errors.IsFailedDeploymentTaskError(err) {
-			tflog.Info(ctx, fmt.Sprintf("Retrying failed deployment task: %s.", err))
-
-			// Retry last deployment task
-			err = WaitHecRetryTask(ctx, acsClient, stack)
-			if err != nil {
-				return diag.Errorf("Error retrying previous task, hec (%s) can't be deleted: %s", hecName, err)
-			}
-
-			// Resubmit request for HEC Update
-			err = WaitHecDelete(ctx, acsClient, stack, hecName)
-			if err != nil {
-				return diag.Errorf("Error submitting request for hec (%s) to be deleted after retrying failed task: %s", hecName, err)
-			}
-		}
 		return diag.Errorf("%s", fmt.Sprintf("Error deleting hec (%s): %s", hecName, err))
 	}
 
@@ -0,0 +1,235 @@
+package hec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyList = "scp_hec_tokens_list"
+
+	NamePrefixKey   = "name_prefix"
+	AllowedIndexKey = "allowed_index"
+	DefaultHostKey  = "default_host"
+	NamesKey        = "names"
+	TokensKey       = "tokens"
+)
+
+func hecTokensListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		NamePrefixKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return hec tokens whose name starts with the prefix. By default every hec token is returned.",
+		},
+		DisabledKey: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Only return hec tokens that are disabled (true) or enabled (false). By default hec tokens are returned regardless of their state.",
+		},
+		AllowedIndexKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return hec tokens whose allowed indexes contain the index. By default every hec token is returned.",
+		},
+		NamesKey: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sorted names of the matching hec tokens.",
+		},
+		TokensKey: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					NameKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the hec token.",
+					},
+					AllowedIndexesKey: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of indexes allowed for events with this token.",
+					},
+					DefaultIndexKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Index to store generated events.",
+					},
+					DefaultHostKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Default host for events with this token.",
+					},
+					DefaultSourceKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Default source for events with this token.",
+					},
+					DefaultSourcetypeKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Default sourcetype for events with this token.",
+					},
+					DisabledKey: {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Input disabled indicator: false = Input Not disabled, true = Input disabled.",
+					},
+					UseAckKey: {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indexer acknowledgement for this token: false = disabled, true = enabled.",
+					},
+					MetaKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Metadata for the HEC token in the format: key::value, delimited by spaces.",
+					},
+					TokenKey: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "Token value for sending data to collector/event endpoint.",
+					},
+				},
+			},
+			Description: "The matching hec tokens sorted by name.",
+		},
+	}
+}
+
+func DataSourceHecTokensList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Hec Tokens List Data Source. Use this data source to list every hec token of the stack, optionally filtered " +
+			"by name prefix, disabled state and allowed index, for example to audit hec tokens created outside of Terraform. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageHecTokens " +
+			"for more latest, detailed information on attribute requirements and the ACS Hec Token API.",
+
+		ReadContext: dataSourceHecTokensListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: hecTokensListDataSourceSchema(),
+	}
+}
+
+func dataSourceHecTokensListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	namePrefix := d.Get(NamePrefixKey).(string)
+	allowedIndex := d.Get(AllowedIndexKey).(string)
+
+	// disabled is only used as a filter when it is set in config, false is a valid filter value
+	var disabled *bool
+	if rawDisabled := d.GetRawConfig().GetAttr(DisabledKey); !rawDisabled.IsNull() {
+		disabledVal := rawDisabled.True()
+		disabled = &disabledVal
+	}
+
+	hecs, err := WaitHecList(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error listing HEC tokens: %s", err)
+	}
+
+	filtered := FilterHecTokens(hecs, namePrefix, disabled, allowedIndex)
+
+	names := make([]string, 0, len(filtered))
+	flattened := make([]interface{}, 0, len(filtered))
+	for _, hec := range filtered {
+		names = append(names, hec.Name)
+		flattened = append(flattened, FlattenHecToken(hec))
+	}
+
+	if err := d.Set(NamesKey, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(TokensKey, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	disabledID := ""
+	if disabled != nil {
+		disabledID = fmt.Sprintf("%t", *disabled)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", stack, namePrefix, disabledID, allowedIndex))
+
+	return nil
+}
+
+// FilterHecTokens returns the hec tokens matching the name prefix, disabled state and allowed index sorted by name,
+// an empty prefix or index and a nil disabled state match every hec token
+func FilterHecTokens(hecs []v2.HecSpec, namePrefix string, disabled *bool, allowedIndex string) []v2.HecSpec {
+	filtered := make([]v2.HecSpec, 0, len(hecs))
+	for _, hec := range hecs {
+		if !strings.HasPrefix(hec.Name, namePrefix) {
+			continue
+		}
+		if disabled != nil && (hec.Disabled != nil && *hec.Disabled) != *disabled {
+			continue
+		}
+		if allowedIndex != "" && !containsIndex(hec.AllowedIndexes, allowedIndex) {
+			continue
+		}
+		filtered = append(filtered, hec)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered
+}
+
+// FlattenHecToken converts a hec spec to the map of attributes of the tokens attribute
+func FlattenHecToken(hec v2.HecSpec) map[string]interface{} {
+	allowedIndexes := make([]string, 0)
+	if hec.AllowedIndexes != nil {
+		allowedIndexes = *hec.AllowedIndexes
+	}
+	return map[string]interface{}{
+		NameKey:              hec.Name,
+		AllowedIndexesKey:    allowedIndexes,
+		DefaultIndexKey:      stringValue(hec.DefaultIndex),
+		DefaultHostKey:       stringValue(hec.DefaultHost),
+		DefaultSourceKey:     stringValue(hec.DefaultSource),
+		DefaultSourcetypeKey: stringValue(hec.DefaultSourcetype),
+		DisabledKey:          hec.Disabled != nil && *hec.Disabled,
+		UseAckKey:            hec.UseAck != nil && *hec.UseAck,
+		MetaKey:              stringValue(hec.Meta),
+		TokenKey:             stringValue(hec.Token),
+	}
+}
+
+func containsIndex(allowedIndexes *[]string, index string) bool {
+	if allowedIndexes == nil {
+		return false
+	}
+	for _, allowedIndex := range *allowedIndexes {
+		if allowedIndex == index {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,81 @@
+package hec_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/hec"
+	"github.com/stretchr/testify/assert"
+)
+
+const hecTokensListDataSourceTemplate = `
+data "scp_hec_tokens_list" "enabled" {
+	disabled = false
+}
+`
+
+func TestAcc_SplunkCloudHecTokensList_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hecTokensListDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_hec_tokens_list.enabled", "id"),
+					resource.TestCheckResourceAttrSet("data.scp_hec_tokens_list.enabled", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func Test_FilterHecTokens(t *testing.T) {
+	enabled := false
+	disabled := true
+	hecs := []v2.HecSpec{
+		{Name: "team-b-app", Disabled: &enabled, AllowedIndexes: &[]string{"main", "app"}},
+		{Name: "team-a-app", Disabled: &disabled, AllowedIndexes: &[]string{"app"}},
+		{Name: "legacy"},
+	}
+
+	names := func(filtered []v2.HecSpec) []string {
+		result := make([]string, 0, len(filtered))
+		for _, hecSpec := range filtered {
+			result = append(result, hecSpec.Name)
+		}
+		return result
+	}
+
+	t.Run("without filters", func(t *testing.T) {
+		assert.Equal(t, []string{"legacy", "team-a-app", "team-b-app"}, names(hec.FilterHecTokens(hecs, "", nil, "")))
+	})
+
+	t.Run("with name prefix", func(t *testing.T) {
+		assert.Equal(t, []string{"team-a-app", "team-b-app"}, names(hec.FilterHecTokens(hecs, "team-", nil, "")))
+	})
+
+	t.Run("with disabled false matches tokens without disabled state", func(t *testing.T) {
+		assert.Equal(t, []string{"legacy", "team-b-app"}, names(hec.FilterHecTokens(hecs, "", &enabled, "")))
+	})
+
+	t.Run("with disabled true", func(t *testing.T) {
+		assert.Equal(t, []string{"team-a-app"}, names(hec.FilterHecTokens(hecs, "", &disabled, "")))
+	})
+
+	t.Run("with allowed index", func(t *testing.T) {
+		assert.Equal(t, []string{"team-b-app"}, names(hec.FilterHecTokens(hecs, "", nil, "main")))
+	})
+}
+
+func Test_FlattenHecToken(t *testing.T) {
+	flattened := hec.FlattenHecToken(v2.HecSpec{Name: mockHecName, Token: &mockToken, DefaultIndex: &mockDefaultIndex})
+	assert.Equal(t, mockHecName, flattened[hec.NameKey])
+	assert.Equal(t, mockToken, flattened[hec.TokenKey])
+	assert.Equal(t, mockDefaultIndex, flattened[hec.DefaultIndexKey])
+	assert.Equal(t, "", flattened[hec.DefaultSourceKey])
+	assert.Equal(t, []string{}, flattened[hec.AllowedIndexesKey])
+	assert.Equal(t, false, flattened[hec.DisabledKey])
+}
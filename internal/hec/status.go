@@ -18,6 +18,19 @@ type Body struct {
 	HTTPEventCollector *v2.HecInfo `json:"http-event-collector"`
 }
 
+// ListBody accepts both the documented and the generated key of the list of hec tokens
+type ListBody struct {
+	HTTPEventCollectors          *[]v2.HecInfo `json:"http-event-collectors,omitempty"`
+	HTTPEventCollectorsGenerated *[]v2.HecInfo `json:"http_event_collectors,omitempty"`
+}
+
+// ListPage is a page of hec tokens, Size counts every hec token ACS returned for the page including the ones without
+// spec that are left out of Hecs, so that paging does not stop early on a full page
+type ListPage struct {
+	Hecs []v2.HecSpec
+	Size int
+}
+
 var GeneralRetryableStatusCodes = map[int]string{
 	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
 }
@@ -81,6 +94,50 @@ func StatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stac
 	}
 }
 
+// StatusList returns StateRefreshFunc that makes GET request for a page of hec tokens, checks if request was successful, and returns the page of hec tokens
+func StatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListHECsParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListHECs(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		page := ListPage{Hecs: make([]v2.HecSpec, 0)}
+		if resp.StatusCode == 200 {
+			var hecs ListBody
+			if err = json.Unmarshal(bodyBytes, &hecs); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if hecs.HTTPEventCollectors == nil {
+				hecs.HTTPEventCollectors = hecs.HTTPEventCollectorsGenerated
+			}
+			if hecs.HTTPEventCollectors != nil {
+				page.Size = len(*hecs.HTTPEventCollectors)
+				for _, hec := range *hecs.HTTPEventCollectors {
+					if hec.Spec == nil {
+						continue
+					}
+					hecSpec := *hec.Spec
+					hecSpec.Token = hec.Token
+					page.Hecs = append(page.Hecs, hecSpec)
+				}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &page, status, nil
+	}
+}
+
 // StatusDelete returns StateRefreshFunc that makes DELETE request and checks if request was accepted
 func StatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, hecName string) resource.StateRefreshFunc {
 	return func() (any, string, error) {
@@ -169,61 +226,3 @@ func VerifyHecUpdate(patchRequest v2.PatchHECJSONRequestBody, hec v2.HecSpec) bo
 	}
 	return true
 }
-
-// StatusRetryTaskComplete returns StateRefreshFunc that makes GET request and checks if request was successful. If the request was successful, we return
-// deployment info to access status
-func StatusRetryTaskComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, deploymentID string) resource.StateRefreshFunc {
-	return func() (any, string, error) {
-		resp, err := acsClient.DescribeDeployment(ctx, stack, v2.DeploymentID(deploymentID))
-		if err != nil {
-			return nil, "", &resource.UnexpectedStateError{LastError: err}
-		}
-
-		defer resp.Body.Close()
-
-		bodyBytes, _ := io.ReadAll(resp.Body)
-
-		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != 200 {
-			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{LastError: errors.New(string(bodyBytes))}
-		}
-
-		var deploymentInfo v2.DeploymentInfo
-		statusText := http.StatusText(resp.StatusCode)
-
-		if resp.StatusCode == 200 {
-			if err = json.Unmarshal(bodyBytes, &deploymentInfo); err != nil {
-				return nil, "", &resource.UnexpectedStateError{LastError: err}
-			}
-			if deploymentInfo.Status != nil {
-				statusText = *deploymentInfo.Status
-			}
-			return &deploymentInfo, statusText, nil
-		}
-		return nil, statusText, nil
-	}
-}
-
-// StatusRetryTask returns StateRefreshFunc that makes POST request and checks if request was accepted
-func StatusRetryTask(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
-	return func() (any, string, error) {
-		resp, err := acsClient.RetryDeployment(ctx, stack)
-		if err != nil {
-			return nil, "", &resource.UnexpectedStateError{LastError: err}
-		}
-		defer resp.Body.Close()
-		bodyBytes, _ := io.ReadAll(resp.Body)
-
-		_, statusText, statusErr := status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
-
-		var deploymentInfo v2.DeploymentInfo
-
-		if resp.StatusCode == 202 {
-			if err = json.Unmarshal(bodyBytes, &deploymentInfo); err != nil {
-				return nil, "", &resource.UnexpectedStateError{LastError: err}
-			}
-			return &deploymentInfo, statusText, statusErr
-		}
-
-		return nil, statusText, statusErr
-	}
-}
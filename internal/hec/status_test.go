@@ -29,40 +29,11 @@ var (
 	mockUseAck            = false
 	mockMeta              = "key1::value1 key2::value2"
 	mockAllowedIndexes    = []string{"main", "summary"}
-	mockDeploymentID      = "mock-id"
-	mockStatusRunning     = "running"
-	mockStatusSucceeded   = "completed"
-	mockStatusFailed      = "failed"
-	mockStatusNew         = "new"
 
 	mockUnupdated               = "some-other-value"
 	mockUnupdatedAllowedIndexes = []string{"main", "index1"}
 	mockUnupdatedBool           = true
 
-	successRespSucceeded = &http.Response{
-		StatusCode: http.StatusOK,
-		Status:     "200 OK",
-		Body:       io.NopCloser(bytes.NewReader(deploymentInfoSucceededBody)),
-	}
-
-	successRespFailed = &http.Response{
-		StatusCode: http.StatusOK,
-		Status:     "200 OK",
-		Body:       io.NopCloser(bytes.NewReader(deploymentInfoFailedBody)),
-	}
-
-	successRespNew = &http.Response{
-		StatusCode: http.StatusAccepted,
-		Status:     "202-Accepted",
-		Body:       io.NopCloser(bytes.NewReader(deploymentInfoNewBody)),
-	}
-
-	successRespRunning = &http.Response{
-		StatusCode: http.StatusOK,
-		Status:     "200 OK",
-		Body:       io.NopCloser(bytes.NewReader(deploymentInfoRunningBody)),
-	}
-
 	acceptedResp = &http.Response{
 		StatusCode: http.StatusAccepted,
 		Body:       io.NopCloser(bytes.NewReader(nil)),
@@ -93,11 +64,6 @@ var (
 		StatusCode: http.StatusFailedDependency,
 		Body:       io.NopCloser(bytes.NewReader(nil)),
 	}
-
-	deploymentInfoRunningBody, _   = json.Marshal(&v2.DeploymentInfo{Id: mockDeploymentID, Status: &mockStatusRunning})
-	deploymentInfoSucceededBody, _ = json.Marshal(&v2.DeploymentInfo{Id: mockDeploymentID, Status: &mockStatusSucceeded})
-	deploymentInfoFailedBody, _    = json.Marshal(&v2.DeploymentInfo{Id: mockDeploymentID, Status: &mockStatusFailed})
-	deploymentInfoNewBody, _       = json.Marshal(&v2.DeploymentInfo{Id: mockDeploymentID, Status: &mockStatusNew})
 )
 
 func genHecResp(code int) *http.Response {
@@ -135,31 +101,6 @@ func genHecResp(code int) *http.Response {
 	return recorder.Result()
 }
 
-// nolint
-func genDeploymentInfoResp(code int, status string) *http.Response {
-	var b []byte
-	if code == http.StatusOK {
-		deploymentInfo := v2.DeploymentInfo{
-			Id:     mockDeploymentID,
-			Status: &status,
-		}
-
-		b, _ = json.Marshal(&deploymentInfo)
-	} else {
-		b, _ = json.Marshal(&v2.Error{
-			Code:    http.StatusText(code),
-			Message: http.StatusText(code),
-		})
-	}
-	recorder := httptest.NewRecorder()
-	recorder.Header().Add("Content-Type", "json")
-	recorder.WriteHeader(code)
-	if b != nil {
-		_, _ = recorder.Write(b)
-	}
-	return recorder.Result()
-}
-
 func Test_VerifyHecUpdate(t *testing.T) {
 	assert := assert.New(t)
 
@@ -512,3 +453,12 @@ func Test_TestIsSliceEqual(t *testing.T) {
 		})
 	}
 }
+
+func genHecListResp(hecs []v2.HecInfo) *http.Response {
+	b, _ := json.Marshal(&hec.ListBody{HTTPEventCollectors: &hecs})
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(http.StatusOK)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
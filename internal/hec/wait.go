@@ -11,15 +11,9 @@ import (
 	"github.com/splunk/terraform-provider-scp/internal/wait"
 )
 
-var (
-	taskStatusFailed    = "failed"
-	taskStatusSucceeded = "completed"
-	taskStatusNew       = "new"
-	taskStatusRunning   = "running"
-)
-
 const (
-	DeploymentTaskFailedErr = "retry of deployment task %s resulted in failed status upon completion"
+	// HecPageSize is the number of hec tokens requested per ListHECs call
+	HecPageSize = 100
 )
 
 // WaitHecCreate Handles retry logic for POST requests for create lifecycle function
@@ -64,6 +58,30 @@ func WaitHecRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Sta
 	return hec, nil
 }
 
+// WaitHecList Handles retry logic for GET requests listing hec tokens, pages through every hec token of the stack
+func WaitHecList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.HecSpec, error) {
+	count := v2.Count(HecPageSize)
+
+	hecs := make([]v2.HecSpec, 0)
+	for offset := v2.Offset(0); ; offset += HecPageSize {
+		pageOffset := offset
+		params := v2.ListHECsParams{Count: &count, Offset: &pageOffset}
+		waitHecList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, StatusList(ctx, acsClient, stack, params))
+
+		output, err := waitHecList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing HEC tokens at offset (%d): %s", offset, err))
+			return nil, err
+		}
+		page := output.(*ListPage)
+		hecs = append(hecs, page.Hecs...)
+
+		if page.Size < HecPageSize {
+			return hecs, nil
+		}
+	}
+}
+
 // WaitHecUpdate Handles retry logic for PATCH requests for the update lifecycle function
 func WaitHecUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, patchRequest v2.PatchHECJSONRequestBody, hecName string) error {
 	waitHecUpdateAccepted := wait.GenerateWriteStateChangeConf(StatusUpdate(ctx, acsClient, stack, patchRequest, hecName))
@@ -114,43 +132,3 @@ func WaitHecDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.S
 	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for hec (%s): %s\n", hecName, resp.Header.Get("X-REQUEST-ID")))
 	return nil
 }
-
-// WaitHecRetryTaskComplete Handles retry logic for GET requests to check status of deployment task until completion
-func WaitHecRetryTaskComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, deploymentID string) error {
-	pendingState := []string{http.StatusText(http.StatusTooManyRequests), taskStatusRunning, taskStatusNew}
-	targetState := []string{taskStatusFailed, taskStatusSucceeded}
-	WaitRetryTaskComplete := wait.GenerateReadStateChangeConf(pendingState, targetState, StatusRetryTaskComplete(ctx, acsClient, stack, deploymentID))
-
-	output, err := WaitRetryTaskComplete.WaitForStateContext(ctx)
-	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error checking status of deployment (%s): %s", deploymentID, err))
-		return err
-	}
-
-	deploymentInfo := output.(*v2.DeploymentInfo)
-
-	if *deploymentInfo.Status == taskStatusFailed {
-		tflog.Error(ctx, fmt.Sprintf("retry of deployment task %s failed", deploymentID))
-		return fmt.Errorf(DeploymentTaskFailedErr, deploymentID)
-	}
-	return nil
-}
-
-// WaitHecRetryTask Handles retry logic for retrying a previously failed deployment task.
-func WaitHecRetryTask(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) error {
-	// Retry last deployment task
-	waitRetryTaskAccepted := wait.GenerateWriteStateChangeConf(StatusRetryTask(ctx, acsClient, stack))
-	output, err := waitRetryTaskAccepted.WaitForStateContext(ctx)
-	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error retrying previous task: %s \n", err))
-		return err
-	}
-
-	deploymentInfo := output.(*v2.DeploymentInfo)
-
-	tflog.Info(ctx, fmt.Sprintf("Retry task status: %d\n", deploymentInfo.Status))
-	tflog.Info(ctx, fmt.Sprintf("Retry task deployment id: %s\n", deploymentInfo.Id))
-
-	// Poll retry task status until completion
-	return WaitHecRetryTaskComplete(ctx, acsClient, stack, deploymentInfo.Id)
-}
@@ -277,100 +277,68 @@ func Test_WaitHecDelete(t *testing.T) {
 	})
 }
 
-func Test_WaitHecRetryTask(t *testing.T) {
+func Test_WaitHecList(t *testing.T) {
 	client := &mocks.ClientInterface{}
 
 	t.Run("with some client interface error", func(_ *testing.T) {
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(nil, errors.New("some error")).Once()
-		err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		hecs, err := hec.WaitHecList(context.TODO(), client, mockStack)
 		assert.Error(t, err)
+		assert.Nil(t, hecs)
 	})
 
-	t.Run("with retry task successful", func(t *testing.T) {
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(successRespNew, nil).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespSucceeded, nil).Once()
-		err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
-		assert.NoError(t, err)
-	})
-
-	t.Run("with retry deployment task failed", func(t *testing.T) {
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(successRespNew, nil).Once()
-		err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
-		assert.Error(t, err)
-	})
-
-	t.Run("with deployment task failed", func(t *testing.T) {
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(successRespNew, nil).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespFailed, nil).Once()
-		err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
-		assert.Error(t, err)
-	})
-
-	t.Run("with retry on rate limit", func(t *testing.T) {
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(rateLimitResp, nil).Once()
-		client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(successRespNew, nil).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespSucceeded, nil).Once()
-		err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genHecListResp([]v2.HecInfo{
+			{Spec: &v2.HecSpec{Name: mockHecName}, Token: &mockToken},
+		}), nil).Once()
+		hecs, err := hec.WaitHecList(context.TODO(), client, mockStack)
 		assert.NoError(t, err)
+		assert.Len(t, hecs, 1)
+		assert.Equal(t, mockToken, *hecs[0].Token)
 	})
 
-	t.Run("with unexpected error resp", func(t *testing.T) {
-		for _, code := range unexpectedStatusCodes {
-			t.Run(fmt.Sprintf("with unexpected response %v", code), func(t *testing.T) {
-				client.On("RetryDeployment", mock.Anything, v2.Stack(mockStack)).Return(badReqResp, nil).Once()
-				client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(badReqResp, nil).Once()
-
-				err := hec.WaitHecRetryTask(context.TODO(), client, mockStack)
-				assert.Error(t, err)
-			})
+	t.Run("with multiple pages", func(t *testing.T) {
+		fullPage := make([]v2.HecInfo, hec.HecPageSize)
+		for i := range fullPage {
+			fullPage[i] = v2.HecInfo{Spec: &v2.HecSpec{Name: fmt.Sprintf("%s-%d", mockHecName, i)}}
 		}
-	})
-}
-
-func Test_WaitHecRetryTaskComplete(t *testing.T) {
-	client := &mocks.ClientInterface{}
-
-	t.Run("with some client interface error", func(_ *testing.T) {
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(nil, errors.New("some error")).Once()
-		err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
-		assert.Error(t, err)
-	})
-
-	t.Run("with retry task successful", func(t *testing.T) {
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespSucceeded, nil).Once()
-		err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListHECsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(genHecListResp(fullPage), nil).Once()
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListHECsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == hec.HecPageSize
+		})).Return(genHecListResp([]v2.HecInfo{{Spec: &v2.HecSpec{Name: mockHecName}}}), nil).Once()
+		hecs, err := hec.WaitHecList(context.TODO(), client, mockStack)
 		assert.NoError(t, err)
+		assert.Len(t, hecs, hec.HecPageSize+1)
 	})
 
-	t.Run("with deployment task failed", func(t *testing.T) {
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespFailed, nil).Once()
-		err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
-		assert.Error(t, err)
-		assert.ErrorContains(t, err, fmt.Sprintf(hec.DeploymentTaskFailedErr, mockDeploymentID))
-	})
-
-	t.Run("with retry on rate limit", func(t *testing.T) {
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(rateLimitResp, nil).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespSucceeded, nil).Once()
-		err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
-		assert.NoError(t, err)
-	})
-
-	t.Run("with retry on running task", func(t *testing.T) {
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespRunning, nil).Once()
-		client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(successRespSucceeded, nil).Once()
-		err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+	t.Run("with full page containing hec token without spec", func(t *testing.T) {
+		fullPage := make([]v2.HecInfo, hec.HecPageSize)
+		for i := range fullPage {
+			fullPage[i] = v2.HecInfo{Spec: &v2.HecSpec{Name: fmt.Sprintf("%s-%d", mockHecName, i)}}
+		}
+		fullPage[0] = v2.HecInfo{Token: &mockToken}
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListHECsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(genHecListResp(fullPage), nil).Once()
+		client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListHECsParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == hec.HecPageSize
+		})).Return(genHecListResp([]v2.HecInfo{{Spec: &v2.HecSpec{Name: mockHecName}}}), nil).Once()
+		hecs, err := hec.WaitHecList(context.TODO(), client, mockStack)
 		assert.NoError(t, err)
+		assert.Len(t, hecs, hec.HecPageSize)
+		assert.Equal(t, mockHecName, hecs[len(hecs)-1].Name)
+		client.AssertExpectations(t)
 	})
 
-	t.Run("with unexpected error resp", func(t *testing.T) {
+	t.Run("with unexpected response", func(t *testing.T) {
 		for _, code := range unexpectedStatusCodes {
-			t.Run(fmt.Sprintf("with unexpected response %v", code), func(t *testing.T) {
-				client.On("DescribeDeployment", mock.Anything, v2.Stack(mockStack), v2.DeploymentID(mockDeploymentID)).Return(badReqResp, nil).Once()
-
-				err := hec.WaitHecRetryTaskComplete(context.TODO(), client, mockStack, mockDeploymentID)
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("ListHECs", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genHecResp(code), nil).Once()
+				hecs, err := hec.WaitHecList(context.TODO(), client, mockStack)
 				assert.Error(t, err)
+				assert.Nil(t, hecs)
 			})
 		}
 	})
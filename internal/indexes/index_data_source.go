@@ -14,13 +14,13 @@ import (
 )
 
 func indexDataSourceSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"name": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The name of the index.",
-		},
+	attributes := indexAttributesSchema()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the index.",
 	}
+	return attributes
 }
 
 func DataSourceIndex() *schema.Resource {
@@ -62,8 +62,10 @@ func dataSourceIndexRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("Error reading index (%s): %s", indexName, err)
 	}
 
-	if err := d.Set("name", index.Name); err != nil {
-		return diag.FromErr(err)
+	for key, value := range FlattenIndex(*index) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId(index.Name)
@@ -22,8 +22,11 @@ func TestAcc_SplunkCloudIndex_DataSource_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(indexDataSourceTemplate, indexName),
-				Check: resource.TestCheckResourceAttr(
-					fmt.Sprintf("data.scp_indexes.%s", indexName), "name", indexName,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_indexes.%s", indexName), "name", indexName),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.scp_indexes.%s", indexName), "datatype", "event"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_indexes.%s", indexName), "searchable_days"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.scp_indexes.%s", indexName), "max_data_size_mb"),
 				),
 			},
 		},
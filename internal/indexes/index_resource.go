@@ -3,7 +3,6 @@ package indexes
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -12,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/status"
 	"github.com/splunk/terraform-provider-scp/internal/wait"
 )
@@ -106,9 +107,11 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	tflog.Info(ctx, fmt.Sprintf("%+v\n", createIndexRequest))
 
-	err := WaitIndexCreate(ctx, acsClient, stack, createIndexRequest)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitIndexCreate(ctx, acsClient, stack, createIndexRequest)
+	})
 	if err != nil {
-		if stateErr := err.(*resource.UnexpectedStateError); stateErr.State == http.StatusText(http.StatusConflict) {
+		if errors.IsConflictError(err) {
 			return diag.Errorf("Index (%s) already exists, use a different name to create index or use terraform import to bring current index under terraform management", indexRequest.Name)
 		}
 
@@ -194,7 +197,9 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		SelfStorageBucketPath:       indexRequest.SelfStorageBucketPath,
 	}
 
-	err := WaitIndexUpdate(ctx, acsClient, stack, patchRequest, indexName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitIndexUpdate(ctx, acsClient, stack, patchRequest, indexName)
+	})
 	if err != nil {
 		return diag.Errorf("Error submitting request for index (%s) to be updated: %s", indexName, err)
 	}
@@ -217,7 +222,9 @@ func resourceIndexDelete(ctx context.Context, d *schema.ResourceData, m interfac
 
 	indexName := d.Id()
 
-	err := WaitIndexDelete(ctx, acsClient, stack, indexName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitIndexDelete(ctx, acsClient, stack, indexName)
+	})
 	if err != nil {
 		return diag.Errorf("Error deleting index (%s): %s", indexName, err)
 	}
@@ -0,0 +1,202 @@
+package indexes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyList = "scp_indexes_list"
+)
+
+// indexAttributesSchema returns the computed attributes of an index as returned by ACS
+func indexAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the index.",
+		},
+		"datatype": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the index, either event or metric.",
+		},
+		"searchable_days": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Number of days after which indexed data rolls to frozen.",
+		},
+		"max_data_size_mb": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The maximum size in MB for a hot DB to reach before a roll to warm is triggered. 0 means unlimited.",
+		},
+		"self_storage_bucket_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The self storage (DDSS) bucket path frozen data is moved to. Empty if DDSS is not enabled.",
+		},
+		"splunk_archival_retention_days": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Number of days archived (DDAA) data is retained. 0 if DDAA is not enabled.",
+		},
+		"total_event_count": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The total number of events in the index.",
+		},
+		"total_raw_size_mb": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The total raw size of the index in MB.",
+		},
+	}
+}
+
+func indexesListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"datatype": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"event", "metric"}, false)),
+			Description:      "Valid values: (event | metric). Only return indexes of the datatype. By default indexes of every datatype are returned.",
+		},
+		"name_regex": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			Description:      "Only return indexes whose name matches the regular expression. By default every index is returned.",
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sorted names of the matching indexes.",
+		},
+		"indexes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: indexAttributesSchema(),
+			},
+			Description: "The matching indexes sorted by name.",
+		},
+	}
+}
+
+func DataSourceIndexesList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Indexes List Data Source. Use this data source to list every index of the stack, optionally filtered " +
+			"by datatype and name, for example to generate the indexes a role is allowed to search from the live inventory. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageIndexes " +
+			"for more latest, detailed information on attribute requirements and the ACS Indexes API.",
+
+		ReadContext: dataSourceIndexesListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: indexesListDataSourceSchema(),
+	}
+}
+
+func dataSourceIndexesListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	datatype := d.Get("datatype").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	var nameRegexp *regexp.Regexp
+	if nameRegex != "" {
+		var err error
+		if nameRegexp, err = regexp.Compile(nameRegex); err != nil {
+			return diag.Errorf("Error compiling name_regex (%s): %s", nameRegex, err)
+		}
+	}
+
+	indexes, err := WaitIndexList(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error listing indexes: %s", err)
+	}
+
+	filtered := FilterIndexes(indexes, datatype, nameRegexp)
+
+	names := make([]string, 0, len(filtered))
+	flattened := make([]interface{}, 0, len(filtered))
+	for _, index := range filtered {
+		names = append(names, index.Name)
+		flattened = append(flattened, FlattenIndex(index))
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("indexes", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", stack, datatype, nameRegex))
+
+	return nil
+}
+
+// FilterIndexes returns the indexes matching the datatype and name regular expression sorted by name, an empty
+// datatype or nil regular expression matches every index
+func FilterIndexes(indexes []v2.IndexResponse, datatype string, nameRegexp *regexp.Regexp) []v2.IndexResponse {
+	filtered := make([]v2.IndexResponse, 0, len(indexes))
+	for _, index := range indexes {
+		if datatype != "" && index.Datatype != datatype {
+			continue
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(index.Name) {
+			continue
+		}
+		filtered = append(filtered, index)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered
+}
+
+// FlattenIndex converts an index response to the map of attributes defined by indexAttributesSchema
+func FlattenIndex(index v2.IndexResponse) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"name":                           index.Name,
+		"datatype":                       index.Datatype,
+		"searchable_days":                index.SearchableDays,
+		"max_data_size_mb":               index.MaxDataSizeMB,
+		"self_storage_bucket_path":       "",
+		"splunk_archival_retention_days": uint64(0),
+		"total_event_count":              "",
+		"total_raw_size_mb":              "",
+	}
+	if index.SelfStorageBucketPath != nil {
+		flattened["self_storage_bucket_path"] = *index.SelfStorageBucketPath
+	}
+	if index.SplunkArchivalRetentionDays != nil {
+		flattened["splunk_archival_retention_days"] = *index.SplunkArchivalRetentionDays
+	}
+	if index.TotalEventCount != nil {
+		flattened["total_event_count"] = *index.TotalEventCount
+	}
+	if index.TotalRawSizeMB != nil {
+		flattened["total_raw_size_mb"] = *index.TotalRawSizeMB
+	}
+	return flattened
+}
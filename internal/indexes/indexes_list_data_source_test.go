@@ -0,0 +1,91 @@
+package indexes_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	idx "github.com/splunk/terraform-provider-scp/internal/indexes"
+	"github.com/stretchr/testify/assert"
+)
+
+const indexesListDataSourceTemplate = `
+data "scp_indexes_list" "main" {
+	datatype   = "event"
+	name_regex = "^main$"
+}
+`
+
+func TestAcc_SplunkCloudIndexesList_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: indexesListDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scp_indexes_list.main", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.scp_indexes_list.main", "names.0", "main"),
+					resource.TestCheckResourceAttr("data.scp_indexes_list.main", "indexes.0.datatype", "event"),
+					resource.TestCheckResourceAttrSet("data.scp_indexes_list.main", "indexes.0.searchable_days"),
+				),
+			},
+		},
+	})
+}
+
+func Test_FilterIndexes(t *testing.T) {
+	indexes := []v2.IndexResponse{
+		{Name: "web_prod", Datatype: "event"},
+		{Name: "main", Datatype: "event"},
+		{Name: "web_metrics", Datatype: "metric"},
+	}
+
+	names := func(filtered []v2.IndexResponse) []string {
+		result := make([]string, 0, len(filtered))
+		for _, index := range filtered {
+			result = append(result, index.Name)
+		}
+		return result
+	}
+
+	t.Run("without filters", func(t *testing.T) {
+		assert.Equal(t, []string{"main", "web_metrics", "web_prod"}, names(idx.FilterIndexes(indexes, "", nil)))
+	})
+
+	t.Run("with datatype", func(t *testing.T) {
+		assert.Equal(t, []string{"web_metrics"}, names(idx.FilterIndexes(indexes, "metric", nil)))
+	})
+
+	t.Run("with name regex", func(t *testing.T) {
+		assert.Equal(t, []string{"web_metrics", "web_prod"}, names(idx.FilterIndexes(indexes, "", regexp.MustCompile("^web_"))))
+	})
+
+	t.Run("with datatype and name regex", func(t *testing.T) {
+		assert.Equal(t, []string{"web_prod"}, names(idx.FilterIndexes(indexes, "event", regexp.MustCompile("^web_"))))
+	})
+}
+
+func Test_FlattenIndex(t *testing.T) {
+	t.Run("with optional attributes unset", func(t *testing.T) {
+		flattened := idx.FlattenIndex(v2.IndexResponse{Name: mockIndexName, Datatype: "event", SearchableDays: 90})
+		assert.Equal(t, "", flattened["self_storage_bucket_path"])
+		assert.Equal(t, uint64(0), flattened["splunk_archival_retention_days"])
+		assert.Equal(t, "", flattened["total_event_count"])
+	})
+
+	t.Run("with optional attributes set", func(t *testing.T) {
+		totalEventCount := "42"
+		flattened := idx.FlattenIndex(v2.IndexResponse{
+			Name:                        mockIndexName,
+			SelfStorageBucketPath:       &mockSelfStorageBucketPath,
+			SplunkArchivalRetentionDays: uint64Ptr(mockSplunkArchivalRetentionDays),
+			TotalEventCount:             &totalEventCount,
+		})
+		assert.Equal(t, mockSelfStorageBucketPath, flattened["self_storage_bucket_path"])
+		assert.Equal(t, uint64(1099), flattened["splunk_archival_retention_days"])
+		assert.Equal(t, totalEventCount, flattened["total_event_count"])
+	})
+}
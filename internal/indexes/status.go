@@ -154,3 +154,41 @@ func VerifyIndexUpdate(patchRequest v2.PatchIndexInfoJSONRequestBody, index v2.I
 
 	return true
 }
+
+// IndexStatusList returns StateRefreshFunc that makes GET request for a page of indexes, checks if request was successful, and returns the indexes of the page
+func IndexStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListIndexesParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListIndexes(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		indexes := make([]v2.IndexResponse, 0)
+		if resp.StatusCode == http.StatusOK {
+			// ACS returns either a bare list of indexes or the list wrapped in an "indexes" object
+			if err = json.Unmarshal(bodyBytes, &indexes); err != nil {
+				var body struct {
+					Indexes *[]v2.IndexResponse `json:"indexes,omitempty"`
+				}
+				if err = json.Unmarshal(bodyBytes, &body); err != nil {
+					return nil, "", &resource.UnexpectedStateError{LastError: err}
+				}
+				if body.Indexes != nil {
+					indexes = *body.Indexes
+				}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return indexes, status, nil
+	}
+}
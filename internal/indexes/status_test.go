@@ -293,3 +293,12 @@ func Test_VerifyIndexUpdate(t *testing.T) {
 		})
 	}
 }
+
+func genIndexListResp(indexes []v2.IndexResponse) *http.Response {
+	b, _ := json.Marshal(indexes)
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(http.StatusOK)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
@@ -10,6 +10,11 @@ import (
 	"net/http"
 )
 
+const (
+	// IndexPageSize is the number of indexes requested per ListIndexes call
+	IndexPageSize = 100
+)
+
 // WaitIndexCreate Handles retry logic for POST requests for create lifecycle function
 func WaitIndexCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createIndexRequest v2.CreateIndexJSONRequestBody) error {
 	waitIndexCreateAccepted := wait.GenerateWriteStateChangeConf(IndexStatusCreate(ctx, acsClient, stack, createIndexRequest))
@@ -102,3 +107,27 @@ func WaitIndexDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2
 	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for index (%s): %s\n", indexName, resp.Header.Get("X-REQUEST-ID")))
 	return nil
 }
+
+// WaitIndexList Handles retry logic for GET requests listing indexes, pages through every index of the stack
+func WaitIndexList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.IndexResponse, error) {
+	count := v2.Count(IndexPageSize)
+
+	indexes := make([]v2.IndexResponse, 0)
+	for offset := v2.Offset(0); ; offset += IndexPageSize {
+		pageOffset := offset
+		params := v2.ListIndexesParams{Count: &count, Offset: &pageOffset}
+		waitIndexList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, IndexStatusList(ctx, acsClient, stack, params))
+
+		output, err := waitIndexList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing indexes at offset (%d): %s", offset, err))
+			return nil, err
+		}
+		page := output.([]v2.IndexResponse)
+		indexes = append(indexes, page...)
+
+		if len(page) < IndexPageSize {
+			return indexes, nil
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
@@ -293,3 +294,56 @@ func Test_WaitIndexDelete(t *testing.T) {
 		}
 	})
 }
+
+func Test_WaitIndexList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		indexes, err := idx.WaitIndexList(context.TODO(), client, mockStack)
+		assert.Error(t, err)
+		assert.Nil(t, indexes)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genIndexResp(429), nil).Once()
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genIndexListResp([]v2.IndexResponse{{Name: mockIndexName}}), nil).Once()
+		indexes, err := idx.WaitIndexList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, indexes, 1)
+	})
+
+	t.Run("with wrapped indexes response", func(t *testing.T) {
+		wrapped := httptest.NewRecorder()
+		wrapped.WriteHeader(http.StatusOK)
+		_, _ = wrapped.Write([]byte(`{"indexes":[{"name":"mock-index","datatype":"event"}]}`))
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(wrapped.Result(), nil).Once()
+		indexes, err := idx.WaitIndexList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Equal(t, []v2.IndexResponse{{Name: mockIndexName, Datatype: "event"}}, indexes)
+	})
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		fullPage := make([]v2.IndexResponse, idx.IndexPageSize)
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListIndexesParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(genIndexListResp(fullPage), nil).Once()
+		client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListIndexesParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == idx.IndexPageSize
+		})).Return(genIndexListResp([]v2.IndexResponse{{Name: mockIndexName}}), nil).Once()
+		indexes, err := idx.WaitIndexList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, indexes, idx.IndexPageSize+1)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, unexpectedStatusCode := range []int{400, 401, 403, 404, 409, 501, 500, 503} {
+			t.Run(fmt.Sprintf("with unexpected response %v", unexpectedStatusCode), func(t *testing.T) {
+				client.On("ListIndexes", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genIndexResp(unexpectedStatusCode), nil).Once()
+				indexes, err := idx.WaitIndexList(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, indexes)
+			})
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 )
 
@@ -68,7 +69,9 @@ func resourceIPAllowlistCreate(ctx context.Context, d *schema.ResourceData, m in
 	addSubnets := GetSubnetsFromSet(newSubnetsSet)
 
 	// Add new subnets
-	err := WaitIPAllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitIPAllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+	})
 	if err != nil {
 		if errors.IsUnknownFeatureError(err) {
 			tflog.Info(ctx, fmt.Sprintf("Invalid IP Allowlist feature (%s): %s.", feature, err))
@@ -132,7 +135,9 @@ func resourceIPAllowlistUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	if len(deleteSubnets) > 0 {
-		if err := WaitIPAllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPAllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets)
+		}); err != nil {
 			// if feature not found set id of resource to empty string to remove from state
 			if errors.IsUnknownFeatureError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Invalid IP Allowlist feature (%s): %s.", feature, err))
@@ -143,7 +148,9 @@ func resourceIPAllowlistUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	if len(addSubnets) > 0 {
-		if err := WaitIPAllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPAllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+		}); err != nil {
 			return diag.Errorf("%s", fmt.Sprintf("Error updating ip allowlist (%s): %s", feature, err))
 		}
 	}
@@ -164,7 +171,9 @@ func resourceIPAllowlistDelete(ctx context.Context, d *schema.ResourceData, m in
 	deleteSubnets := GetSubnetsFromSet(oldSubnetsSet)
 
 	if len(deleteSubnets) > 0 {
-		if err := WaitIPAllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPAllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets)
+		}); err != nil {
 			// if feature not found set id of resource to empty string to remove from state
 			if errors.IsUnknownFeatureError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Invalid IP Allowlist feature (%s): %s.", feature, err))
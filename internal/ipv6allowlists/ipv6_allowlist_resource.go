@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/utils"
 )
@@ -69,7 +70,9 @@ func resourceIPv6AllowlistCreate(ctx context.Context, d *schema.ResourceData, m
 	addSubnets := utils.GetSubnetsFromSet(newSubnetsSet)
 
 	// Add new subnets
-	err := WaitIPv6AllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitIPv6AllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+	})
 	if err != nil {
 		if errors.IsUnknownFeatureError(err) {
 			tflog.Info(ctx, fmt.Sprintf("Invalid IPv6 Allowlist feature (%s): %s.", feature, err))
@@ -133,7 +136,9 @@ func resourceIPv6AllowlistUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if len(deleteSubnets) > 0 {
-		if err := WaitIPv6AllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPv6AllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets)
+		}); err != nil {
 			// if feature not found set id of resource to empty string to remove from state
 			if errors.IsUnknownFeatureError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Invalid IPv6 Allowlist feature (%s): %s.", feature, err))
@@ -144,7 +149,9 @@ func resourceIPv6AllowlistUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if len(addSubnets) > 0 {
-		if err := WaitIPv6AllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPv6AllowlistCreate(ctx, acsClient, stack, v2.Feature(feature), addSubnets)
+		}); err != nil {
 			return diag.Errorf("Error updating ipv6 allowlist (%s): %s", feature, err)
 		}
 	}
@@ -165,7 +172,9 @@ func resourceIPv6AllowlistDelete(ctx context.Context, d *schema.ResourceData, m
 	deleteSubnets := utils.GetSubnetsFromSet(oldSubnetsSet)
 
 	if len(deleteSubnets) > 0 {
-		if err := WaitIPv6AllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets); err != nil {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitIPv6AllowlistDelete(ctx, acsClient, stack, v2.Feature(feature), deleteSubnets)
+		}); err != nil {
 			// if feature not found set id of resource to empty string to remove from state
 			if errors.IsUnknownFeatureError(err) {
 				tflog.Info(ctx, fmt.Sprintf("Invalid IPv6 Allowlist feature (%s): %s.", feature, err))
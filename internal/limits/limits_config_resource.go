@@ -0,0 +1,357 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_limits_config"
+
+	schemaKeyStanza   = "stanza"
+	schemaKeySettings = "settings"
+)
+
+func limitsConfigResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyStanza: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "The limits.conf stanza to configure. No two resources should have the same stanza. Can not be updated after creation, " +
+				"if changed in config file terraform will propose a replacement (reset settings of old stanza and configure the new stanza).",
+		},
+		schemaKeySettings: {
+			Type:     schema.TypeMap,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Map of limits.conf settings to their values for the stanza. Only the settings in this map are managed, " +
+				"settings removed from the map are reset to their default value.",
+		},
+	}
+}
+
+func ResourceLimitsConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Limits Config Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageLimits " +
+			"for more latest, detailed information on attribute requirements and the ACS Limits API.",
+
+		CreateContext: resourceLimitsConfigCreate,
+		ReadContext:   resourceLimitsConfigRead,
+		UpdateContext: resourceLimitsConfigUpdate,
+		DeleteContext: resourceLimitsConfigDelete,
+		CustomizeDiff: customizeLimitsConfigDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLimitsConfigImport,
+		},
+
+		Schema: limitsConfigResourceSchema(),
+	}
+}
+
+func resourceLimitsConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Get(schemaKeyStanza).(string)
+	settings := parseSettings(d.Get(schemaKeySettings))
+
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitLimitConfigAdd(ctx, acsClient, stack, stanza, settings)
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for limits config stanza (%s) to be created: %s", stanza, err)
+	}
+
+	// Poll each setting until ACS returns the new value
+	err = WaitLimitConfigVerifyApplied(ctx, acsClient, stack, stanza, settings)
+	if err != nil {
+		return diag.Errorf("Error waiting for limits config stanza (%s) to be created: %s", stanza, err)
+	}
+
+	// Set ID of limits config resource to the stanza to indicate settings have been applied
+	d.SetId(stanza)
+	tflog.Info(ctx, fmt.Sprintf("Created limits config resource: %s\n", stanza))
+
+	// Call read to set attributes of limits config
+	return resourceLimitsConfigRead(ctx, d, m)
+}
+
+func resourceLimitsConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Id()
+
+	actual, err := WaitLimitConfigRead(ctx, acsClient, stack, stanza)
+	if err != nil {
+		// if stanza not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing limits config from state. Not Found error while reading limits config stanza (%s): %s.", stanza, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading limits config stanza (%s): %s", stanza, err)
+	}
+
+	if err := d.Set(schemaKeyStanza, stanza); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySettings, MatchManagedSettings(actual, parseSettings(d.Get(schemaKeySettings)))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLimitsConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Get(schemaKeyStanza).(string)
+	rawOldSettings, rawNewSettings := d.GetChange(schemaKeySettings)
+	oldSettings := parseSettings(rawOldSettings)
+	newSettings := parseSettings(rawNewSettings)
+
+	changedSettings := make(map[string]string)
+	for key, value := range newSettings {
+		if oldValue, ok := oldSettings[key]; !ok || !LimitValuesEqual(oldValue, value) {
+			changedSettings[key] = value
+		}
+	}
+
+	resetKeys := make([]string, 0)
+	for key := range oldSettings {
+		if _, ok := newSettings[key]; !ok {
+			resetKeys = append(resetKeys, key)
+		}
+	}
+	sort.Strings(resetKeys)
+
+	if len(changedSettings) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitLimitConfigAdd(ctx, acsClient, stack, stanza, changedSettings)
+		}); err != nil {
+			return diag.Errorf("Error updating limits config stanza (%s): %s", stanza, err)
+		}
+		if err := WaitLimitConfigVerifyApplied(ctx, acsClient, stack, stanza, changedSettings); err != nil {
+			return diag.Errorf("Error waiting for limits config stanza (%s) to be updated: %s", stanza, err)
+		}
+	}
+
+	if len(resetKeys) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitLimitConfigReset(ctx, acsClient, stack, stanza, resetKeys)
+		}); err != nil {
+			return diag.Errorf("Error resetting limits config settings (%s) of stanza (%s): %s", strings.Join(resetKeys, ", "), stanza, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated limits config resource: %s\n", stanza))
+
+	return resourceLimitsConfigRead(ctx, d, m)
+}
+
+func resourceLimitsConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanza := d.Get(schemaKeyStanza).(string)
+
+	// Only the settings managed by terraform are reset, other settings of the stanza are left untouched
+	resetKeys := make([]string, 0)
+	for key := range parseSettings(d.Get(schemaKeySettings)) {
+		resetKeys = append(resetKeys, key)
+	}
+	sort.Strings(resetKeys)
+
+	if len(resetKeys) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitLimitConfigReset(ctx, acsClient, stack, stanza, resetKeys)
+		}); err != nil {
+			if errors.IsNotFoundError(err) {
+				tflog.Info(ctx, fmt.Sprintf("Limits config stanza (%s) already removed: %s.", stanza, err))
+				return nil
+			}
+			return diag.Errorf("Error deleting limits config stanza (%s): %s", stanza, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted limits config resource: %s\n", stanza))
+	return nil
+}
+
+func resourceLimitsConfigImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	stanza, keys, err := ParseLimitsConfigImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// seed the managed setting keys, their values are read from the stanza by the read that follows the import
+	settings := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		settings[key] = ""
+	}
+
+	d.SetId(stanza)
+	if err := d.Set(schemaKeyStanza, stanza); err != nil {
+		return nil, err
+	}
+	if err := d.Set(schemaKeySettings, settings); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeLimitsConfigDiff rejects settings unknown to the stanza or outside the bounds returned by ACS at plan time
+func customizeLimitsConfigDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(schemaKeyStanza) || !d.NewValueKnown(schemaKeySettings) || m == nil {
+		return nil
+	}
+	if !d.HasChange(schemaKeySettings) && !d.HasChange(schemaKeyStanza) {
+		return nil
+	}
+
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stanzas, err := WaitLimitDefaultsRead(ctx, acsClient, stack)
+	if err != nil {
+		return fmt.Errorf("error reading limits config defaults to validate settings: %s", err)
+	}
+
+	return ValidateLimitSettings(d.Get(schemaKeyStanza).(string), parseSettings(d.Get(schemaKeySettings)), stanzas)
+}
+
+// ValidateLimitSettings checks that the stanza and each setting are configurable and numeric values are within the
+// MinValue/MaxValue bounds of the setting
+func ValidateLimitSettings(stanza string, settings map[string]string, stanzas []v2.LimitStanza) error {
+	var limitStanza *v2.LimitStanza
+	for i := range stanzas {
+		if stanzas[i].Stanza != nil && *stanzas[i].Stanza == stanza {
+			limitStanza = &stanzas[i]
+			break
+		}
+	}
+	if limitStanza == nil {
+		return fmt.Errorf("limits config stanza (%s) is not configurable through ACS", stanza)
+	}
+
+	limitSettings := make(map[string]v2.LimitSetting)
+	if limitStanza.Settings != nil {
+		for _, setting := range *limitStanza.Settings {
+			if setting.Setting != nil {
+				limitSettings[*setting.Setting] = setting
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		limitSetting, ok := limitSettings[key]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("setting (%s) is not configurable for stanza (%s)", key, stanza))
+			continue
+		}
+		if limitSetting.MinValue == nil && limitSetting.MaxValue == nil {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(settings[key], 64)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("setting (%s) of stanza (%s) must be a number, got (%s)", key, stanza, settings[key]))
+			continue
+		}
+		if limitSetting.MinValue != nil && value < *limitSetting.MinValue {
+			errs = append(errs, fmt.Sprintf("setting (%s) of stanza (%s) must be at least %s, got (%s)", key, stanza, FormatLimitValue(*limitSetting.MinValue), settings[key]))
+		}
+		if limitSetting.MaxValue != nil && value > *limitSetting.MaxValue {
+			errs = append(errs, fmt.Sprintf("setting (%s) of stanza (%s) must be at most %s, got (%s)", key, stanza, FormatLimitValue(*limitSetting.MaxValue), settings[key]))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid limits config settings: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// MatchManagedSettings returns the actual values of the managed settings, keeping the managed notation of values that
+// are numerically equal so that "100" and "100.0" do not show as drift. Settings of the stanza that are not managed are
+// never returned, so that they are not reset on update or delete.
+func MatchManagedSettings(actual map[string]string, managed map[string]string) map[string]string {
+	matched := make(map[string]string)
+	for key, managedValue := range managed {
+		actualValue, ok := actual[key]
+		if !ok {
+			continue
+		}
+		if LimitValuesEqual(actualValue, managedValue) {
+			matched[key] = managedValue
+		} else {
+			matched[key] = actualValue
+		}
+	}
+	return matched
+}
+
+func parseSettings(rawSettings interface{}) map[string]string {
+	settings := make(map[string]string)
+	if rawSettings == nil {
+		return settings
+	}
+	for key, value := range rawSettings.(map[string]interface{}) {
+		settings[key] = value.(string)
+	}
+	return settings
+}
+
+// ParseLimitsConfigImportID splits an import ID in the format stanza/key1,key2 into its stanza and the setting keys to
+// manage. The stanza may contain slashes, the setting keys are taken from after the last one.
+func ParseLimitsConfigImportID(id string) (string, []string, error) {
+	separator := strings.LastIndex(id, "/")
+	if separator <= 0 {
+		return "", nil, fmt.Errorf("unexpected format of ID (%s), expected stanza/key1,key2", id)
+	}
+
+	stanza := id[:separator]
+	keys := strings.Split(id[separator+1:], ",")
+	for i, key := range keys {
+		keys[i] = strings.TrimSpace(key)
+		if keys[i] == "" {
+			return "", nil, fmt.Errorf("unexpected format of ID (%s), expected stanza/key1,key2", id)
+		}
+	}
+	return stanza, keys, nil
+}
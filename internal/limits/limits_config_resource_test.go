@@ -0,0 +1,91 @@
+package limits_test
+
+import (
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR LIMITS CONFIG RESOURCE
+
+Limits settings have no deleted state, destroying the resource resets the managed settings to the defaults of the stack,
+so CheckDestroy can not tell a reset setting from one that was never changed. The configurable settings also differ per
+stack, the validation against them and the matching of managed settings are covered below.
+*/
+
+func Test_ValidateLimitSettings(t *testing.T) {
+	stanza := mockStanza
+	setting := mockKey
+	otherSetting := "enable_history"
+	minValue := float64(10)
+	maxValue := float64(1000)
+	stanzas := []v2.LimitStanza{
+		{
+			Stanza: &stanza,
+			Settings: &[]v2.LimitSetting{
+				{Setting: &setting, MinValue: &minValue, MaxValue: &maxValue},
+				{Setting: &otherSetting},
+			},
+		},
+	}
+
+	t.Run("with valid settings", func(t *testing.T) {
+		err := limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "200", otherSetting: "true"}, stanzas)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with settings on the bounds", func(t *testing.T) {
+		assert.NoError(t, limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "10"}, stanzas))
+		assert.NoError(t, limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "1000"}, stanzas))
+	})
+
+	t.Run("with settings out of bounds", func(t *testing.T) {
+		assert.ErrorContains(t, limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "9"}, stanzas), "must be at least 10")
+		assert.ErrorContains(t, limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "1000.5"}, stanzas), "must be at most 1000")
+	})
+
+	t.Run("with non numeric bounded setting", func(t *testing.T) {
+		assert.ErrorContains(t, limits.ValidateLimitSettings(mockStanza, map[string]string{mockKey: "lots"}, stanzas), "must be a number")
+	})
+
+	t.Run("with unknown setting", func(t *testing.T) {
+		assert.ErrorContains(t, limits.ValidateLimitSettings(mockStanza, map[string]string{"unknown": "1"}, stanzas), "not configurable")
+	})
+
+	t.Run("with unknown stanza", func(t *testing.T) {
+		assert.ErrorContains(t, limits.ValidateLimitSettings("unknown", map[string]string{mockKey: "200"}, stanzas), "not configurable")
+	})
+}
+
+func Test_MatchManagedSettings(t *testing.T) {
+	actual := map[string]string{mockKey: "200", "enable_history": "false", "unmanaged": "1"}
+
+	t.Run("with managed settings", func(t *testing.T) {
+		matched := limits.MatchManagedSettings(actual, map[string]string{mockKey: "200.0", "enable_history": "true", "missing": "1"})
+		assert.Equal(t, map[string]string{mockKey: "200.0", "enable_history": "false"}, matched)
+	})
+
+	t.Run("with no managed settings", func(t *testing.T) {
+		assert.Empty(t, limits.MatchManagedSettings(actual, map[string]string{}))
+	})
+
+	t.Run("with imported setting keys", func(t *testing.T) {
+		matched := limits.MatchManagedSettings(actual, map[string]string{mockKey: "", "enable_history": ""})
+		assert.Equal(t, map[string]string{mockKey: "200", "enable_history": "false"}, matched)
+	})
+}
+
+func Test_ParseLimitsConfigImportID(t *testing.T) {
+	stanza, keys, err := limits.ParseLimitsConfigImportID(mockStanza + "/" + mockKey + ",enable_history")
+	assert.NoError(t, err)
+	assert.Equal(t, mockStanza, stanza)
+	assert.Equal(t, []string{mockKey, "enable_history"}, keys)
+
+	for _, id := range []string{"", mockStanza, mockStanza + "/", "/" + mockKey, mockStanza + "/" + mockKey + ","} {
+		_, _, err = limits.ParseLimitsConfigImportID(id)
+		assert.Error(t, err, id)
+	}
+}
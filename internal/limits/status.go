@@ -0,0 +1,206 @@
+package limits
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// limitConfigBody is the response body returned when reading a stanza or a single key
+type limitConfigBody struct {
+	Limitconfiguration *v2.LimitConfigurationResponse `json:"limitconfiguration,omitempty"`
+}
+
+// LimitConfigStatusAdd returns StateRefreshFunc that makes POST request to set stanza settings and checks if request was accepted
+func LimitConfigStatusAdd(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		requestSettings := make(map[string]interface{}, len(settings))
+		for key, value := range settings {
+			requestSettings[key] = toRequestValue(value)
+		}
+		addBody := v2.AddLimitConfigJSONRequestBody{
+			Settings: &requestSettings,
+		}
+		resp, err := acsClient.AddLimitConfig(ctx, stack, v2.Stanza(stanza), addBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// LimitConfigStatusReset returns StateRefreshFunc that makes POST request to reset stanza settings to their defaults and checks if request was accepted
+func LimitConfigStatusReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, keys []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resetSettings := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			resetSettings = append(resetSettings, key)
+		}
+		resetBody := v2.ResetLimitConfigJSONRequestBody{
+			Settings: &resetSettings,
+		}
+		resp, err := acsClient.ResetLimitConfig(ctx, stack, v2.Stanza(stanza), resetBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// LimitConfigStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the stanza settings
+func LimitConfigStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetLimitConfig(ctx, stack, v2.Stanza(stanza))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return processLimitConfigResponse(resp)
+	}
+}
+
+// LimitConfigStatusVerifyKey returns StateRefreshFunc that makes GET request for a single key and checks if it holds the expected value
+func LimitConfigStatusVerifyKey(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, key string, expected string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetKeyLimitConfig(ctx, stack, v2.Stanza(stanza), v2.Key(key))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		output, statusText, err := processLimitConfigResponse(resp)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return output, statusText, err
+		}
+
+		settings := output.(map[string]string)
+		if value, ok := settings[key]; ok && LimitValuesEqual(value, expected) {
+			return settings, status.UpdatedStatus, nil
+		}
+		return settings, statusText, nil
+	}
+}
+
+// LimitDefaultsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the defaults and bounds of every configurable stanza
+func LimitDefaultsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetAllLimitsConfigDefaults(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		stanzas := make([]v2.LimitStanza, 0)
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &stanzas); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return stanzas, status, nil
+	}
+}
+
+func processLimitConfigResponse(resp *http.Response) (any, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+		return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+			State:         http.StatusText(resp.StatusCode),
+			ExpectedState: wait.TargetStatusResourceExists,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	settings := make(map[string]string)
+	if resp.StatusCode == http.StatusOK {
+		var body limitConfigBody
+		if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		if body.Limitconfiguration != nil && body.Limitconfiguration.Settings != nil {
+			settings = ParseLimitSettings(*body.Limitconfiguration.Settings)
+		}
+	}
+	status := http.StatusText(resp.StatusCode)
+	return settings, status, nil
+}
+
+// ParseLimitSettings flattens the settings returned by ACS into a key/value map. Settings are either returned as
+// {"setting": key, "value": value} objects or as objects mapping each key to its value.
+func ParseLimitSettings(rawSettings []interface{}) map[string]string {
+	settings := make(map[string]string)
+	for _, rawSetting := range rawSettings {
+		setting, ok := rawSetting.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if key, ok := setting["setting"].(string); ok {
+			settings[key] = FormatLimitValue(setting["value"])
+			continue
+		}
+		for key, value := range setting {
+			settings[key] = FormatLimitValue(value)
+		}
+	}
+	return settings
+}
+
+// FormatLimitValue converts a setting value decoded from JSON to its string representation
+func FormatLimitValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// LimitValuesEqual compares setting values numerically when both are numbers, so that "100" and "100.0" are equal
+func LimitValuesEqual(a string, b string) bool {
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return aNum == bNum
+	}
+	return a == b
+}
+
+// toRequestValue sends numeric settings as JSON numbers and everything else as strings
+func toRequestValue(value string) interface{} {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return json.Number(value)
+	}
+	return value
+}
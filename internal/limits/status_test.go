@@ -0,0 +1,119 @@
+package limits_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_LimitConfigStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusOK, mockSettings), nil).Once()
+		output, statusText, err := limits.LimitConfigStatusRead(context.TODO(), client, v2.Stack(mockStack), mockStanza)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockSettings, output.(map[string]string))
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := limits.LimitConfigStatusRead(context.TODO(), client, v2.Stack(mockStack), mockStanza)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(genLimitConfigResp(http.StatusNotFound, nil), nil).Once()
+		output, statusText, err := limits.LimitConfigStatusRead(context.TODO(), client, v2.Stack(mockStack), mockStanza)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza)).Return(nil, errors.New("some error")).Once()
+		output, _, err := limits.LimitConfigStatusRead(context.TODO(), client, v2.Stack(mockStack), mockStanza)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_LimitConfigStatusVerifyKey(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with value applied", func(t *testing.T) {
+		client.On("GetKeyLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), v2.Key(mockKey)).Return(genLimitConfigResp(http.StatusOK, map[string]string{mockKey: "200"}), nil).Once()
+		_, statusText, err := limits.LimitConfigStatusVerifyKey(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockKey, "200.0")()
+		assert.NoError(t, err)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with previous value", func(t *testing.T) {
+		client.On("GetKeyLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), v2.Key(mockKey)).Return(genLimitConfigResp(http.StatusOK, map[string]string{mockKey: "100"}), nil).Once()
+		_, statusText, err := limits.LimitConfigStatusVerifyKey(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockKey, "200")()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+}
+
+func Test_ParseLimitSettings(t *testing.T) {
+	t.Run("with setting/value objects", func(t *testing.T) {
+		settings := limits.ParseLimitSettings([]interface{}{
+			map[string]interface{}{"setting": "max_mem_usage_mb", "value": float64(200)},
+			map[string]interface{}{"setting": "enable_history", "value": "true"},
+		})
+		assert.Equal(t, map[string]string{"max_mem_usage_mb": "200", "enable_history": "true"}, settings)
+	})
+
+	t.Run("with key/value objects", func(t *testing.T) {
+		settings := limits.ParseLimitSettings([]interface{}{
+			map[string]interface{}{"max_mem_usage_mb": float64(0.5)},
+			"ignored",
+		})
+		assert.Equal(t, map[string]string{"max_mem_usage_mb": "0.5"}, settings)
+	})
+}
+
+func Test_LimitValuesEqual(t *testing.T) {
+	assert.True(t, limits.LimitValuesEqual("100", "100.0"))
+	assert.True(t, limits.LimitValuesEqual("true", "true"))
+	assert.False(t, limits.LimitValuesEqual("100", "101"))
+	assert.False(t, limits.LimitValuesEqual("true", "1"))
+}
+
+func genLimitConfigResp(code int, settings map[string]string) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		rawSettings := make([]interface{}, 0, len(settings))
+		for key, value := range settings {
+			rawSettings = append(rawSettings, map[string]interface{}{"setting": key, "value": value})
+		}
+		b, _ = json.Marshal(map[string]interface{}{
+			"limitconfiguration": v2.LimitConfigurationResponse{Settings: &rawSettings},
+		})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
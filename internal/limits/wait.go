@@ -0,0 +1,96 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// PendingStatusVerifyApplied keeps polling while a key still returns its previous value
+	PendingStatusVerifyApplied = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitLimitConfigAdd Handles retry logic for POST requests setting stanza settings for create/update lifecycle functions
+func WaitLimitConfigAdd(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) error {
+	waitLimitConfigAddAccepted := wait.GenerateWriteStateChangeConf(LimitConfigStatusAdd(ctx, acsClient, stack, stanza, settings))
+
+	rawResp, err := waitLimitConfigAddAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for limits config stanza (%s) to be updated: %s", stanza, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for limits config stanza (%s): %d\n", stanza, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for limits config stanza (%s): %s\n", stanza, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitLimitConfigReset Handles retry logic for POST requests resetting stanza settings for update/delete lifecycle functions
+func WaitLimitConfigReset(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, keys []string) error {
+	waitLimitConfigResetAccepted := wait.GenerateWriteStateChangeConf(LimitConfigStatusReset(ctx, acsClient, stack, stanza, keys))
+
+	rawResp, err := waitLimitConfigResetAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for limits config stanza (%s) to be reset: %s", stanza, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and reset in progress
+	tflog.Info(ctx, fmt.Sprintf("Reset response status code for limits config stanza (%s): %d\n", stanza, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for limits config stanza (%s): %s\n", stanza, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitLimitConfigVerifyApplied Handles retry logic for polling each key until ACS returns the value that was set
+func WaitLimitConfigVerifyApplied(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string, settings map[string]string) error {
+	for key, value := range settings {
+		waitKeyApplied := wait.GenerateReadStateChangeConf(PendingStatusVerifyApplied, []string{status.UpdatedStatus}, LimitConfigStatusVerifyKey(ctx, acsClient, stack, stanza, key, value))
+
+		if _, err := waitKeyApplied.WaitForStateContext(ctx); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error waiting for limits config setting (%s/%s) to be applied: %s", stanza, key, err))
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitLimitConfigRead Handles retry logic for GET requests for the read lifecycle function
+func WaitLimitConfigRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, stanza string) (map[string]string, error) {
+	waitLimitConfigRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, LimitConfigStatusRead(ctx, acsClient, stack, stanza))
+
+	output, err := waitLimitConfigRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading limits config stanza (%s): %s", stanza, err))
+		return nil, err
+	}
+	settings := output.(map[string]string)
+
+	return settings, nil
+}
+
+// WaitLimitDefaultsRead Handles retry logic for GET requests for the defaults and bounds of every configurable stanza
+func WaitLimitDefaultsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.LimitStanza, error) {
+	waitLimitDefaultsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, LimitDefaultsStatusRead(ctx, acsClient, stack))
+
+	output, err := waitLimitDefaultsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading limits config defaults: %s", err))
+		return nil, err
+	}
+	stanzas := output.([]v2.LimitStanza)
+
+	return stanzas, nil
+}
@@ -0,0 +1,104 @@
+package limits_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack  = "mock-stack"
+	mockStanza = "search"
+	mockKey    = "max_mem_usage_mb"
+)
+
+var (
+	mockSettings = map[string]string{mockKey: "200", "enable_history": "true"}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitLimitConfigAdd(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := limits.WaitLimitConfigAdd(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockSettings)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.MatchedBy(func(body v2.AddLimitConfigJSONRequestBody) bool {
+			settings := *body.Settings
+			return fmt.Sprint(settings[mockKey]) == "200" && settings["enable_history"] == "true"
+		})).Return(genLimitConfigResp(202, nil), nil).Once()
+		err := limits.WaitLimitConfigAdd(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockSettings)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.Anything).Return(genLimitConfigResp(429, nil), nil).Once()
+		client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.Anything).Return(genLimitConfigResp(202, nil), nil).Once()
+		err := limits.WaitLimitConfigAdd(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockSettings)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("AddLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.Anything).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				err := limits.WaitLimitConfigAdd(context.TODO(), client, v2.Stack(mockStack), mockStanza, mockSettings)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLimitConfigReset(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("ResetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.MatchedBy(func(body v2.ResetLimitConfigJSONRequestBody) bool {
+			return assert.ElementsMatch(t, []interface{}{mockKey}, *body.Settings)
+		})).Return(genLimitConfigResp(202, nil), nil).Once()
+		err := limits.WaitLimitConfigReset(context.TODO(), client, v2.Stack(mockStack), mockStanza, []string{mockKey})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ResetLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), mock.Anything).Return(genLimitConfigResp(statusCode, nil), nil).Once()
+				err := limits.WaitLimitConfigReset(context.TODO(), client, v2.Stack(mockStack), mockStanza, []string{mockKey})
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLimitConfigVerifyApplied(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with value applied after previous value", func(t *testing.T) {
+		client.On("GetKeyLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), v2.Key(mockKey)).Return(genLimitConfigResp(200, map[string]string{mockKey: "100"}), nil).Once()
+		client.On("GetKeyLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), v2.Key(mockKey)).Return(genLimitConfigResp(200, map[string]string{mockKey: "200"}), nil).Once()
+		err := limits.WaitLimitConfigVerifyApplied(context.TODO(), client, v2.Stack(mockStack), mockStanza, map[string]string{mockKey: "200"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("GetKeyLimitConfig", mock.Anything, v2.Stack(mockStack), v2.Stanza(mockStanza), v2.Key(mockKey)).Return(genLimitConfigResp(400, nil), nil).Once()
+		err := limits.WaitLimitConfigVerifyApplied(context.TODO(), client, v2.Stack(mockStack), mockStanza, map[string]string{mockKey: "200"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,398 @@
+package maintenancewindows
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_maintenance_window_preferences"
+
+	schemaKeyCustomerInitiatedFreeze = "customer_initiated_freeze"
+	schemaKeySplunkInitiatedFreezes  = "splunk_initiated_freezes"
+	schemaKeyRecordVersion           = "record_version"
+
+	schemaKeyID        = "id"
+	schemaKeyAppliesTo = "applies_to"
+	schemaKeyStartDate = "start_date"
+	schemaKeyEndDate   = "end_date"
+	schemaKeyReason    = "reason"
+	schemaKeyCategory  = "category"
+	schemaKeyTickets   = "tickets"
+
+	// maxRecordVersionConflicts is the number of times an update is retried when the preferences were modified concurrently
+	maxRecordVersionConflicts = 3
+)
+
+var freezeDateRegexp = regexp.MustCompile(`^\d{4}/\d{2}/\d{2}$`)
+
+func preferencesResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyCustomerInitiatedFreeze: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "UUID of the change freeze.",
+					},
+					schemaKeyAppliesTo: {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						Description:      "Type of changes the change freeze applies to.",
+					},
+					schemaKeyStartDate: {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(freezeDateRegexp, "date must be in the format YYYY/MM/DD")),
+						Description:      "Date (YYYY/MM/DD) when the change freeze starts.",
+					},
+					schemaKeyEndDate: {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(freezeDateRegexp, "date must be in the format YYYY/MM/DD")),
+						Description:      "Date (YYYY/MM/DD) when the change freeze ends.",
+					},
+					schemaKeyReason: {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						Description:      "Reason for the change freeze.",
+					},
+					schemaKeyTickets: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Support tickets associated with the change freeze.",
+					},
+				},
+			},
+			Description: "A change freeze requested by the customer. Change freezes not declared in the config file are removed.",
+		},
+		schemaKeySplunkInitiatedFreezes: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "UUID of the change freeze.",
+					},
+					schemaKeyAppliesTo: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Type of changes the change freeze applies to.",
+					},
+					schemaKeyCategory: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Category of the change freeze.",
+					},
+					schemaKeyStartDate: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Date (YYYY/MM/DD) when the change freeze starts.",
+					},
+					schemaKeyEndDate: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Date (YYYY/MM/DD) when the change freeze ends.",
+					},
+					schemaKeyReason: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Reason for the change freeze.",
+					},
+					schemaKeyTickets: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Tickets associated with the change freeze.",
+					},
+				},
+			},
+			Description: "(Read-only) Change freezes initiated by Splunk. These can not be managed by Terraform.",
+		},
+		schemaKeyRecordVersion: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "(Read-only) Version of the maintenance window preferences record.",
+		},
+	}
+}
+
+func ResourcePreferences() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Window Preferences Resource. Manages the customer initiated change freezes of the stack. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on attribute requirements and the ACS Maintenance Windows API.",
+
+		CreateContext: resourcePreferencesCreate,
+		ReadContext:   resourcePreferencesRead,
+		UpdateContext: resourcePreferencesUpdate,
+		DeleteContext: resourcePreferencesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: preferencesResourceSchema(),
+	}
+}
+
+func resourcePreferencesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	freezes, err := parseCustomerInitiatedFreezes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = updateCustomerInitiatedFreezes(ctx, acsClient, stack, freezes); err != nil {
+		return diag.Errorf("Error creating maintenance window preferences: %s", err)
+	}
+
+	// Preferences are a single record per stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created maintenance window preferences resource: %s\n", stack))
+
+	return resourcePreferencesRead(ctx, d, m)
+}
+
+func resourcePreferencesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	preferences, err := WaitPreferencesRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading maintenance window preferences: %s", err)
+	}
+
+	configured, _ := parseCustomerInitiatedFreezes(d)
+	customerFreezes := OrderCustomerInitiatedFreezes(preferences.ChangeFreezes.CustomerInitiatedFreezes, configured)
+	if err := d.Set(schemaKeyCustomerInitiatedFreeze, flattenCustomerInitiatedFreezes(customerFreezes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySplunkInitiatedFreezes, flattenSplunkInitiatedFreezes(preferences.ChangeFreezes.SplunkInitiatedFreezes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRecordVersion, preferences.RecordVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePreferencesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyCustomerInitiatedFreeze) {
+		freezes, err := parseCustomerInitiatedFreezes(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = updateCustomerInitiatedFreezes(ctx, acsClient, stack, freezes); err != nil {
+			return diag.Errorf("Error updating maintenance window preferences: %s", err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated maintenance window preferences resource: %s\n", stack))
+	}
+
+	return resourcePreferencesRead(ctx, d, m)
+}
+
+func resourcePreferencesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Removing the resource clears every customer initiated change freeze, Splunk initiated freezes are not affected
+	if err := updateCustomerInitiatedFreezes(ctx, acsClient, stack, []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{}); err != nil {
+		return diag.Errorf("Error deleting maintenance window preferences: %s", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted maintenance window preferences resource: %s\n", stack))
+	return nil
+}
+
+// updateCustomerInitiatedFreezes replaces the customer initiated change freezes using the latest record version,
+// re-reading the preferences and retrying when the record was modified concurrently
+func updateCustomerInitiatedFreezes(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest) error {
+	var err error
+	for attempt := 1; attempt <= maxRecordVersionConflicts; attempt++ {
+		var current *v2.MaintenanceWindowsPreferencesResponse
+		current, err = WaitPreferencesRead(ctx, acsClient, stack)
+		if err != nil {
+			return err
+		}
+
+		recordVersion := current.RecordVersion
+		updateRequest := v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody{
+			ChangeFreezes: v2.MaintenanceWindowsChangeFreezeRequest{
+				CustomerInitiatedFreezes: AssignCustomerInitiatedFreezeIDs(freezes, current.ChangeFreezes.CustomerInitiatedFreezes),
+			},
+			RecordVersion: &recordVersion,
+		}
+
+		err = deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitPreferencesUpdate(ctx, acsClient, stack, updateRequest)
+		})
+		if err == nil || !errors.IsConflictError(err) {
+			return err
+		}
+		tflog.Info(ctx, fmt.Sprintf("Maintenance window preferences record version (%d) is outdated, retrying update (attempt %d of %d)", recordVersion, attempt, maxRecordVersionConflicts))
+	}
+	return err
+}
+
+func parseCustomerInitiatedFreezes(d *schema.ResourceData) ([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, error) {
+	rawFreezes := d.Get(schemaKeyCustomerInitiatedFreeze).([]interface{})
+	freezes := make([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, 0, len(rawFreezes))
+
+	for _, rawFreeze := range rawFreezes {
+		freezeMap := rawFreeze.(map[string]interface{})
+		freeze := v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			AppliesTo: freezeMap[schemaKeyAppliesTo].(string),
+			StartDate: freezeMap[schemaKeyStartDate].(string),
+			EndDate:   freezeMap[schemaKeyEndDate].(string),
+			Reason:    freezeMap[schemaKeyReason].(string),
+		}
+		if id, ok := freezeMap[schemaKeyID].(string); ok && id != "" {
+			freeze.Id = &id
+		}
+
+		// dates are zero padded YYYY/MM/DD so they can be compared lexically
+		if freeze.EndDate < freeze.StartDate {
+			return nil, fmt.Errorf("change freeze end_date (%s) must not be before start_date (%s)", freeze.EndDate, freeze.StartDate)
+		}
+		freezes = append(freezes, freeze)
+	}
+
+	return freezes, nil
+}
+
+func flattenCustomerInitiatedFreezes(freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) []interface{} {
+	flattened := make([]interface{}, 0, len(freezes))
+	for _, freeze := range freezes {
+		flattened = append(flattened, map[string]interface{}{
+			schemaKeyID:        freeze.Id,
+			schemaKeyAppliesTo: freeze.AppliesTo,
+			schemaKeyStartDate: freeze.StartDate,
+			schemaKeyEndDate:   freeze.EndDate,
+			schemaKeyReason:    freeze.Reason,
+			schemaKeyTickets:   freeze.Tickets,
+		})
+	}
+	return flattened
+}
+
+func flattenSplunkInitiatedFreezes(freezes []v2.MaintenanceWindowsSplunkInitiatedFreezeResponse) []interface{} {
+	flattened := make([]interface{}, 0, len(freezes))
+	for _, freeze := range freezes {
+		flattened = append(flattened, map[string]interface{}{
+			schemaKeyID:        freeze.Id,
+			schemaKeyAppliesTo: freeze.AppliesTo,
+			schemaKeyCategory:  freeze.Category,
+			schemaKeyStartDate: freeze.StartDate,
+			schemaKeyEndDate:   freeze.EndDate,
+			schemaKeyReason:    freeze.Reason,
+			schemaKeyTickets:   freeze.Tickets,
+		})
+	}
+	return flattened
+}
+
+// AssignCustomerInitiatedFreezeIDs sets the ID of each requested change freeze to the ID of the existing change freeze it
+// updates. Existing freezes are matched on applies_to, start_date and end_date first, then on the ID held in state.
+// Requested freezes without a match are sent without an ID so ACS creates them.
+func AssignCustomerInitiatedFreezeIDs(freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, existing []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse) []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest {
+	assigned := make([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest, len(freezes))
+	usedIDs := make(map[string]bool)
+	matched := make([]bool, len(freezes))
+
+	for i, freeze := range freezes {
+		assigned[i] = freeze
+		assigned[i].Id = nil
+		for _, existingFreeze := range existing {
+			if !usedIDs[existingFreeze.Id] && freezeKey(freeze.AppliesTo, freeze.StartDate, freeze.EndDate) == freezeKey(existingFreeze.AppliesTo, existingFreeze.StartDate, existingFreeze.EndDate) {
+				id := existingFreeze.Id
+				assigned[i].Id = &id
+				usedIDs[id] = true
+				matched[i] = true
+				break
+			}
+		}
+	}
+
+	for i, freeze := range freezes {
+		if matched[i] || freeze.Id == nil || usedIDs[*freeze.Id] {
+			continue
+		}
+		for _, existingFreeze := range existing {
+			if existingFreeze.Id == *freeze.Id {
+				id := existingFreeze.Id
+				assigned[i].Id = &id
+				usedIDs[id] = true
+				break
+			}
+		}
+	}
+
+	return assigned
+}
+
+// OrderCustomerInitiatedFreezes orders the change freezes returned by ACS to follow the configured order so that
+// reordering on the server side does not show up as drift. Change freezes not in the config are appended at the end.
+func OrderCustomerInitiatedFreezes(freezes []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, configured []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest) []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse {
+	ordered := make([]v2.MaintenanceWindowsCustomerInitiatedFreezeResponse, 0, len(freezes))
+	used := make([]bool, len(freezes))
+
+	for _, configuredFreeze := range AssignCustomerInitiatedFreezeIDs(configured, freezes) {
+		if configuredFreeze.Id == nil {
+			continue
+		}
+		for i, freeze := range freezes {
+			if !used[i] && freeze.Id == *configuredFreeze.Id {
+				ordered = append(ordered, freeze)
+				used[i] = true
+				break
+			}
+		}
+	}
+
+	for i, freeze := range freezes {
+		if !used[i] {
+			ordered = append(ordered, freeze)
+		}
+	}
+
+	return ordered
+}
+
+func freezeKey(appliesTo, startDate, endDate string) string {
+	return fmt.Sprintf("%s|%s|%s", appliesTo, startDate, endDate)
+}
@@ -0,0 +1,69 @@
+package maintenancewindows_test
+
+import (
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/maintenancewindows"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR MAINTENANCE WINDOW PREFERENCES RESOURCE
+
+The resource owns every customer initiated change freeze of the stack, so applying it on the acceptance test stack would
+remove the change freezes its owners configured outside of Terraform, and every freeze it creates blocks maintenance of
+that stack for its whole date range.
+*/
+
+func Test_AssignCustomerInitiatedFreezeIDs(t *testing.T) {
+	existing := []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{
+		mockCustomerFreeze,
+		{Id: "other-freeze-id", AppliesTo: mockAppliesTo, StartDate: "2026/12/20", EndDate: "2026/12/31", Reason: "Holidays"},
+	}
+
+	t.Run("with matching dates", func(t *testing.T) {
+		stateID := "other-freeze-id"
+		assigned := maintenancewindows.AssignCustomerInitiatedFreezeIDs([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			{Id: &stateID, AppliesTo: mockAppliesTo, StartDate: "2026/11/20", EndDate: "2026/11/30", Reason: "Cyber Monday"},
+		}, existing)
+		assert.Equal(t, mockCustomerFreeze.Id, *assigned[0].Id)
+	})
+
+	t.Run("with changed dates", func(t *testing.T) {
+		stateID := "other-freeze-id"
+		assigned := maintenancewindows.AssignCustomerInitiatedFreezeIDs([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			{Id: &stateID, AppliesTo: mockAppliesTo, StartDate: "2026/12/18", EndDate: "2026/12/31", Reason: "Holidays"},
+		}, existing)
+		assert.Equal(t, stateID, *assigned[0].Id)
+	})
+
+	t.Run("with new freeze", func(t *testing.T) {
+		assigned := maintenancewindows.AssignCustomerInitiatedFreezeIDs([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			{AppliesTo: mockAppliesTo, StartDate: "2027/01/01", EndDate: "2027/01/02", Reason: "New Year"},
+		}, existing)
+		assert.Nil(t, assigned[0].Id)
+	})
+
+	t.Run("with state id no longer present", func(t *testing.T) {
+		staleID := "deleted-freeze-id"
+		assigned := maintenancewindows.AssignCustomerInitiatedFreezeIDs([]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			{Id: &staleID, AppliesTo: mockAppliesTo, StartDate: "2027/01/01", EndDate: "2027/01/02", Reason: "New Year"},
+		}, existing)
+		assert.Nil(t, assigned[0].Id)
+	})
+}
+
+func Test_OrderCustomerInitiatedFreezes(t *testing.T) {
+	other := v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{Id: "other-freeze-id", AppliesTo: mockAppliesTo, StartDate: "2026/12/20", EndDate: "2026/12/31"}
+	unmanaged := v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{Id: "unmanaged-freeze-id", AppliesTo: mockAppliesTo, StartDate: "2027/02/01", EndDate: "2027/02/02"}
+
+	ordered := maintenancewindows.OrderCustomerInitiatedFreezes(
+		[]v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{unmanaged, mockCustomerFreeze, other},
+		[]v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+			{AppliesTo: mockAppliesTo, StartDate: "2026/12/20", EndDate: "2026/12/31"},
+			{AppliesTo: mockAppliesTo, StartDate: "2026/11/20", EndDate: "2026/11/30"},
+		},
+	)
+	assert.Equal(t, []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{other, mockCustomerFreeze, unmanaged}, ordered)
+}
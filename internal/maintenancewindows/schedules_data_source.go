@@ -0,0 +1,304 @@
+package maintenancewindows
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeySchedules = "scp_maintenance_window_schedules"
+
+	schemaKeyFromTime      = "from_time"
+	schemaKeyToTime        = "to_time"
+	schemaKeyIncludeAudits = "include_audits"
+	schemaKeySchedules     = "schedules"
+
+	schemaKeyStatus                 = "status"
+	schemaKeyMwType                 = "mw_type"
+	schemaKeyDuration               = "duration"
+	schemaKeyScheduleStartTimestamp = "schedule_start_timestamp"
+	schemaKeyScheduleEndTimestamp   = "schedule_end_timestamp"
+	schemaKeyMaintenanceStartedAt   = "maintenance_started_at"
+	schemaKeyMaintenanceEndedAt     = "maintenance_ended_at"
+	schemaKeyLastModifiedTimestamp  = "last_modified_timestamp"
+	schemaKeyRequestedEntity        = "requested_entity"
+	schemaKeyZeroDowntime           = "zero_downtime"
+	schemaKeyOperations             = "operations"
+	schemaKeyAudits                 = "audits"
+
+	schemaKeyDescription = "description"
+	schemaKeyStartTime   = "start_time"
+	schemaKeyEndTime     = "end_time"
+	schemaKeyNotes       = "notes"
+)
+
+var timeRangeDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func timeRangeValidateFunc() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.Any(
+		validation.StringMatch(timeRangeDateRegexp, "time must be in the format YYYY-MM-DD"),
+		validation.IsRFC3339Time,
+	))
+}
+
+// scheduleSchema returns the attributes of a maintenance window schedule, audits are only nested one level deep
+func scheduleSchema(withAudits bool) map[string]*schema.Schema {
+	attributes := map[string]*schema.Schema{
+		schemaKeyID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "UUID of the maintenance window.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the maintenance window schedule.",
+		},
+		schemaKeyMwType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of upgrade performed in the maintenance window.",
+		},
+		schemaKeyDuration: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The duration of the maintenance window.",
+		},
+		schemaKeyScheduleStartTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the maintenance window is scheduled to begin.",
+		},
+		schemaKeyScheduleEndTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the maintenance window is scheduled to end.",
+		},
+		schemaKeyMaintenanceStartedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the maintenance window actually started. Empty until the window begins.",
+		},
+		schemaKeyMaintenanceEndedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the maintenance window actually ended. Empty until the window ends.",
+		},
+		schemaKeyLastModifiedTimestamp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the maintenance window was last modified.",
+		},
+		schemaKeyRequestedEntity: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The entity which requested the maintenance window, either the customer or Splunk.",
+		},
+		schemaKeyZeroDowntime: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the maintenance window will have no impact on the uptime of the stack.",
+		},
+		schemaKeyOperations: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyDescription: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Description of the operation.",
+					},
+					schemaKeyStatus: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Status of the operation.",
+					},
+					schemaKeyZeroDowntime: {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "True if the operation will have no impact on the uptime of the stack.",
+					},
+					schemaKeyStartTime: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time (RFC3339) at which the operation started.",
+					},
+					schemaKeyEndTime: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time (RFC3339) at which the operation ended.",
+					},
+					schemaKeyNotes: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Notes for the customer.",
+					},
+				},
+			},
+			Description: "List of operations being performed in the maintenance window.",
+		},
+	}
+
+	if withAudits {
+		attributes[schemaKeyAudits] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: scheduleSchema(false),
+			},
+			Description: "Historical versions of the maintenance window schedule. Only populated when `include_audits` is true.",
+		}
+	}
+
+	return attributes
+}
+
+func schedulesDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFromTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: timeRangeValidateFunc(),
+			Description:      "The earliest time to return maintenance windows from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyToTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: timeRangeValidateFunc(),
+			Description:      "The latest time to return maintenance windows from. Format is YYYY-MM-DD or RFC3339, UTC is the default timezone.",
+		},
+		schemaKeyIncludeAudits: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to read the audit history of each maintenance window. Requires one request per maintenance window.",
+		},
+		schemaKeySchedules: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: scheduleSchema(true),
+			},
+			Description: "The maintenance windows scheduled within the time range.",
+		},
+	}
+}
+
+func DataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Maintenance Window Schedules Data Source. Use this data source to list the maintenance windows of the stack, " +
+			"for example to alert on upcoming maintenance windows that are not zero downtime. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageMaintenanceWindows " +
+			"for more latest, detailed information on attribute requirements and the ACS Maintenance Windows API.",
+
+		ReadContext: dataSourceSchedulesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: schedulesDataSourceSchema(),
+	}
+}
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	fromTime := d.Get(schemaKeyFromTime).(string)
+	toTime := d.Get(schemaKeyToTime).(string)
+
+	schedules, err := WaitSchedulesList(ctx, acsClient, stack, fromTime, toTime)
+	if err != nil {
+		return diag.Errorf("Error reading maintenance window schedules: %s", err)
+	}
+
+	flattened := make([]interface{}, 0, len(schedules))
+	for _, schedule := range schedules {
+		flattenedSchedule := flattenSchedule(schedule)
+
+		if d.Get(schemaKeyIncludeAudits).(bool) {
+			auditParams := &v2.AuditMaintenanceWindowsScheduleParams{}
+			if fromTime != "" {
+				from := v2.FromTime(fromTime)
+				auditParams.FromTime = &from
+			}
+			if toTime != "" {
+				to := v2.ToTime(toTime)
+				auditParams.ToTime = &to
+			}
+
+			audits, err := WaitScheduleAuditRead(ctx, acsClient, stack, schedule.ScheduleId, auditParams)
+			if err != nil {
+				return diag.Errorf("Error reading audits for maintenance window schedule (%s): %s", schedule.ScheduleId, err)
+			}
+
+			flattenedAudits := make([]interface{}, 0, len(audits.Audits))
+			for _, audit := range audits.Audits {
+				flattenedAudits = append(flattenedAudits, flattenSchedule(audit))
+			}
+			flattenedSchedule[schemaKeyAudits] = flattenedAudits
+		}
+
+		flattened = append(flattened, flattenedSchedule)
+	}
+
+	if err := d.Set(schemaKeySchedules, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", stack, fromTime, toTime))
+
+	return nil
+}
+
+func flattenSchedule(schedule v2.MaintenanceWindowsSchedule) map[string]interface{} {
+	operations := make([]interface{}, 0, len(schedule.Operations))
+	for _, operation := range schedule.Operations {
+		var notes []string
+		if operation.Notes != nil {
+			notes = *operation.Notes
+		}
+		operations = append(operations, map[string]interface{}{
+			schemaKeyDescription:  operation.OperationDescription,
+			schemaKeyStatus:       operation.OperationStatus,
+			schemaKeyZeroDowntime: operation.ZeroDowntime,
+			schemaKeyStartTime:    formatOptionalTime(operation.StartTime),
+			schemaKeyEndTime:      formatOptionalTime(operation.EndTime),
+			schemaKeyNotes:        notes,
+		})
+	}
+
+	return map[string]interface{}{
+		schemaKeyID:                     schedule.ScheduleId,
+		schemaKeyStatus:                 schedule.Status,
+		schemaKeyMwType:                 schedule.MwType,
+		schemaKeyDuration:               schedule.Duration,
+		schemaKeyScheduleStartTimestamp: schedule.ScheduleStartTimestamp.Format(time.RFC3339),
+		schemaKeyScheduleEndTimestamp:   schedule.ScheduleEndTimestamp.Format(time.RFC3339),
+		schemaKeyMaintenanceStartedAt:   formatOptionalTime(schedule.MaintenanceStartedAt),
+		schemaKeyMaintenanceEndedAt:     formatOptionalTime(schedule.MaintenanceEndedAt),
+		schemaKeyLastModifiedTimestamp:  schedule.LastModifiedTimestamp.Format(time.RFC3339),
+		schemaKeyRequestedEntity:        schedule.RequestedEntity,
+		schemaKeyZeroDowntime:           schedule.ZeroDowntime,
+		schemaKeyOperations:             operations,
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,108 @@
+package maintenancewindows_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/maintenancewindows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockFromTime = "2026-11-01"
+	mockToTime   = "2026-12-31"
+)
+
+func Test_WaitSchedulesList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	firstPage := v2.MaintenanceWindowsResponse{
+		NextLink:  "page-2",
+		Schedules: []v2.MaintenanceWindowsSchedule{genSchedule("schedule-1", true)},
+	}
+	lastPage := v2.MaintenanceWindowsResponse{
+		Schedules: []v2.MaintenanceWindowsSchedule{genSchedule("schedule-2", false)},
+	}
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListMaintenanceWindowsSchedulesParams) bool {
+			return params.NextLink == nil && params.FromTime != nil && string(*params.FromTime) == mockFromTime && params.ToTime != nil && string(*params.ToTime) == mockToTime
+		})).Return(genSchedulesResp(http.StatusOK, firstPage), nil).Once()
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListMaintenanceWindowsSchedulesParams) bool {
+			return params.NextLink != nil && string(*params.NextLink) == "page-2"
+		})).Return(genSchedulesResp(http.StatusOK, lastPage), nil).Once()
+
+		schedules, err := maintenancewindows.WaitSchedulesList(context.TODO(), client, v2.Stack(mockStack), mockFromTime, mockToTime)
+		assert.NoError(t, err)
+		assert.Len(t, schedules, 2)
+		assert.Equal(t, "schedule-1", schedules[0].ScheduleId)
+		assert.Equal(t, "schedule-2", schedules[1].ScheduleId)
+	})
+
+	t.Run("with nextLink that does not advance", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genSchedulesResp(http.StatusOK, firstPage), nil).Twice()
+
+		_, err := maintenancewindows.WaitSchedulesList(context.TODO(), client, v2.Stack(mockStack), "", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ListMaintenanceWindowsSchedules", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+
+		_, err := maintenancewindows.WaitSchedulesList(context.TODO(), client, v2.Stack(mockStack), "", "")
+		assert.Error(t, err)
+	})
+}
+
+func Test_ScheduleAuditStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		audits := v2.MaintenanceWindowsAuditResponse{Audits: []v2.MaintenanceWindowsSchedule{genSchedule("schedule-1", true)}}
+		client.On("AuditMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID("schedule-1"), mock.Anything).Return(genSchedulesResp(http.StatusOK, audits), nil).Once()
+		output, statusText, err := maintenancewindows.ScheduleAuditStatusRead(context.TODO(), client, v2.Stack(mockStack), "schedule-1", &v2.AuditMaintenanceWindowsScheduleParams{})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Len(t, output.(*v2.MaintenanceWindowsAuditResponse).Audits, 1)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("AuditMaintenanceWindowsSchedule", mock.Anything, v2.Stack(mockStack), v2.ScheduleID("schedule-1"), mock.Anything).Return(genSchedulesResp(http.StatusNotFound, v2.Error{}), nil).Once()
+		output, statusText, err := maintenancewindows.ScheduleAuditStatusRead(context.TODO(), client, v2.Stack(mockStack), "schedule-1", &v2.AuditMaintenanceWindowsScheduleParams{})()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+}
+
+func genSchedule(id string, zeroDowntime bool) v2.MaintenanceWindowsSchedule {
+	start := time.Date(2026, 11, 10, 2, 0, 0, 0, time.UTC)
+	return v2.MaintenanceWindowsSchedule{
+		ScheduleId:             id,
+		Status:                 "scheduled",
+		MwType:                 "upgrade",
+		Duration:               "4h0m0s",
+		ScheduleStartTimestamp: start,
+		ScheduleEndTimestamp:   start.Add(4 * time.Hour),
+		ZeroDowntime:           zeroDowntime,
+		Operations: []v2.MaintenanceWindowsOperation{
+			{OperationDescription: "Splunk upgrade", OperationStatus: "scheduled", ZeroDowntime: zeroDowntime},
+		},
+	}
+}
+
+func genSchedulesResp(code int, body any) *http.Response {
+	b, _ := json.Marshal(body)
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
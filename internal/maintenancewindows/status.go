@@ -0,0 +1,118 @@
+package maintenancewindows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// PreferencesStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the maintenance window preferences
+func PreferencesStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeMaintenanceWindowsPreferences(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var preferences v2.MaintenanceWindowsPreferencesResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &preferences); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &preferences, status, nil
+	}
+}
+
+// PreferencesStatusUpdate returns StateRefreshFunc that makes PUT request and checks if request was accepted
+func PreferencesStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.UpdateMaintenanceWindowsPreferences(ctx, stack, updateRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// SchedulesStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns a page of maintenance window schedules
+func SchedulesStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params *v2.ListMaintenanceWindowsSchedulesParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListMaintenanceWindowsSchedules(ctx, stack, params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var schedules v2.MaintenanceWindowsResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &schedules); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &schedules, status, nil
+	}
+}
+
+// ScheduleAuditStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the audits of a maintenance window schedule
+func ScheduleAuditStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string, params *v2.AuditMaintenanceWindowsScheduleParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.AuditMaintenanceWindowsSchedule(ctx, stack, v2.ScheduleID(scheduleID), params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var audits v2.MaintenanceWindowsAuditResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &audits); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &audits, status, nil
+	}
+}
@@ -0,0 +1,80 @@
+package maintenancewindows_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/maintenancewindows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_PreferencesStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusOK), nil).Once()
+		output, statusText, err := maintenancewindows.PreferencesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		preferences := output.(*v2.MaintenanceWindowsPreferencesResponse)
+		assert.Equal(t, mockRecordVersion, preferences.RecordVersion)
+		assert.Len(t, preferences.ChangeFreezes.CustomerInitiatedFreezes, 1)
+		assert.Len(t, preferences.ChangeFreezes.SplunkInitiatedFreezes, 1)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusTooManyRequests), nil).Once()
+		_, statusText, err := maintenancewindows.PreferencesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response 400", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(http.StatusBadRequest), nil).Once()
+		output, statusText, err := maintenancewindows.PreferencesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		output, _, err := maintenancewindows.PreferencesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func genPreferencesResp(code int) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(&v2.MaintenanceWindowsPreferencesResponse{
+			ChangeFreezes: v2.MaintenanceWindowsChangeFreezeResponse{
+				CustomerInitiatedFreezes: []v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{mockCustomerFreeze},
+				SplunkInitiatedFreezes: []v2.MaintenanceWindowsSplunkInitiatedFreezeResponse{
+					{Id: "splunk-freeze-id", AppliesTo: mockAppliesTo, Category: "holiday", StartDate: "2026/12/24", EndDate: "2026/12/26"},
+				},
+			},
+			RecordVersion: mockRecordVersion,
+		})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,107 @@
+package maintenancewindows
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// WaitPreferencesRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPreferencesRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.MaintenanceWindowsPreferencesResponse, error) {
+	waitPreferencesRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PreferencesStatusRead(ctx, acsClient, stack))
+
+	output, err := waitPreferencesRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading maintenance window preferences: %s", err))
+		return nil, err
+	}
+	preferences := output.(*v2.MaintenanceWindowsPreferencesResponse)
+
+	return preferences, nil
+}
+
+// WaitPreferencesUpdate Handles retry logic for PUT requests for create/update/delete lifecycle functions
+func WaitPreferencesUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody) error {
+	waitPreferencesUpdateAccepted := wait.GenerateWriteStateChangeConf(PreferencesStatusUpdate(ctx, acsClient, stack, updateRequest))
+
+	rawResp, err := waitPreferencesUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for maintenance window preferences to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for maintenance window preferences: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for maintenance window preferences: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitSchedulesRead Handles retry logic for GET requests for a single page of maintenance window schedules
+func WaitSchedulesRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params *v2.ListMaintenanceWindowsSchedulesParams) (*v2.MaintenanceWindowsResponse, error) {
+	waitSchedulesRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, SchedulesStatusRead(ctx, acsClient, stack, params))
+
+	output, err := waitSchedulesRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading maintenance window schedules: %s", err))
+		return nil, err
+	}
+	schedules := output.(*v2.MaintenanceWindowsResponse)
+
+	return schedules, nil
+}
+
+// WaitSchedulesList follows nextLink until every page of maintenance window schedules has been read
+func WaitSchedulesList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, fromTime string, toTime string) ([]v2.MaintenanceWindowsSchedule, error) {
+	params := &v2.ListMaintenanceWindowsSchedulesParams{}
+	if fromTime != "" {
+		from := v2.FromTime(fromTime)
+		params.FromTime = &from
+	}
+	if toTime != "" {
+		to := v2.ToTime(toTime)
+		params.ToTime = &to
+	}
+
+	schedules := make([]v2.MaintenanceWindowsSchedule, 0)
+	seenLinks := make(map[string]bool)
+	for {
+		page, err := WaitSchedulesRead(ctx, acsClient, stack, params)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, page.Schedules...)
+
+		if page.NextLink == "" || page.NextLink == "null" {
+			return schedules, nil
+		}
+		// guard against a nextLink that does not advance, which would otherwise page forever
+		if seenLinks[page.NextLink] {
+			return nil, fmt.Errorf("maintenance window schedules nextLink (%s) was returned more than once", page.NextLink)
+		}
+		seenLinks[page.NextLink] = true
+
+		nextLink := v2.NextLink(page.NextLink)
+		params.NextLink = &nextLink
+	}
+}
+
+// WaitScheduleAuditRead Handles retry logic for GET requests for the audits of a maintenance window schedule
+func WaitScheduleAuditRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, scheduleID string, params *v2.AuditMaintenanceWindowsScheduleParams) (*v2.MaintenanceWindowsAuditResponse, error) {
+	waitAuditRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, ScheduleAuditStatusRead(ctx, acsClient, stack, scheduleID, params))
+
+	output, err := waitAuditRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading audits for maintenance window schedule (%s): %s", scheduleID, err))
+		return nil, err
+	}
+	audits := output.(*v2.MaintenanceWindowsAuditResponse)
+
+	return audits, nil
+}
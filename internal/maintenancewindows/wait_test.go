@@ -0,0 +1,97 @@
+package maintenancewindows_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/maintenancewindows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack         = "mock-stack"
+	mockAppliesTo     = "all"
+	mockRecordVersion = 3
+)
+
+var (
+	mockCustomerFreeze = v2.MaintenanceWindowsCustomerInitiatedFreezeResponse{
+		Id:        "customer-freeze-id",
+		AppliesTo: mockAppliesTo,
+		StartDate: "2026/11/20",
+		EndDate:   "2026/11/30",
+		Reason:    "Black Friday",
+	}
+	mockRecordVersionValue = mockRecordVersion
+	mockUpdateBody         = v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody{
+		ChangeFreezes: v2.MaintenanceWindowsChangeFreezeRequest{
+			CustomerInitiatedFreezes: []v2.MaintenanceWindowsCustomerInitiatedFreezeRequest{
+				{AppliesTo: mockAppliesTo, StartDate: "2026/11/20", EndDate: "2026/11/30", Reason: "Black Friday"},
+			},
+		},
+		RecordVersion: &mockRecordVersionValue,
+	}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitPreferencesRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(429), nil).Once()
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(genPreferencesResp(200), nil).Once()
+		preferences, err := maintenancewindows.WaitPreferencesRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.Equal(t, mockRecordVersion, preferences.RecordVersion)
+	})
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DescribeMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		_, err := maintenancewindows.WaitPreferencesRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitPreferencesUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), mockUpdateBody).Return(nil, errors.New("some error")).Once()
+		err := maintenancewindows.WaitPreferencesUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateBody)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.UpdateMaintenanceWindowsPreferencesJSONRequestBody) bool {
+			return *body.RecordVersion == mockRecordVersion
+		})).Return(genPreferencesResp(200), nil).Once()
+		err := maintenancewindows.WaitPreferencesUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), mockUpdateBody).Return(genPreferencesResp(429), nil).Once()
+		client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), mockUpdateBody).Return(genPreferencesResp(200), nil).Once()
+		err := maintenancewindows.WaitPreferencesUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateBody)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("UpdateMaintenanceWindowsPreferences", mock.Anything, v2.Stack(mockStack), mockUpdateBody).Return(genPreferencesResp(statusCode), nil).Once()
+				err := maintenancewindows.WaitPreferencesUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateBody)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
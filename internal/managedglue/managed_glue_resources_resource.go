@@ -0,0 +1,383 @@
+package managedglue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+)
+
+const (
+	ResourceKey = "scp_managed_glue_resources"
+
+	schemaKeyManagedGlueResource = "managed_glue_resource"
+	schemaKeyStatus              = "status"
+
+	schemaKeyCloudProvider       = "cloud_provider"
+	schemaKeyDatabase            = "database"
+	schemaKeyTable               = "table"
+	schemaKeyLocationPrefix      = "location_prefix"
+	schemaKeyFileFormat          = "file_format"
+	schemaKeySourceType          = "source_type"
+	schemaKeyFieldDelimiter      = "field_delimiter"
+	schemaKeyPartitionProjection = "partition_projection"
+	schemaKeyExtra               = "extra"
+
+	schemaKeyAccountIDs       = "account_ids"
+	schemaKeyRegions          = "regions"
+	schemaKeyAccountIDKeyName = "account_id_key_name"
+	schemaKeyRegionKeyName    = "region_key_name"
+	schemaKeyTimeKeyName      = "time_key_name"
+	schemaKeyTimeDayKeyName   = "time_day_key_name"
+	schemaKeyTimeHourKeyName  = "time_hour_key_name"
+	schemaKeyTimeMonthKeyName = "time_month_key_name"
+	schemaKeyTimeRange        = "time_range"
+	schemaKeyTimeUnit         = "time_unit"
+
+	schemaKeyColumnIndexes  = "column_indexes"
+	schemaKeyOrgID          = "org_id"
+	schemaKeyPartitionStyle = "partition_style"
+)
+
+func requiredString(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      description,
+	}
+}
+
+// optionalString returns an optional attribute that is also computed, ACS may fill in a default when it is not set
+func optionalString(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func partitionProjectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyAccountIDs: {
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The cloud provider account IDs to project partitions for.",
+		},
+		schemaKeyRegions: {
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The regions to project partitions for.",
+		},
+		schemaKeyAccountIDKeyName: optionalString("The name of the partition key holding the account ID."),
+		schemaKeyRegionKeyName:    optionalString("The name of the partition key holding the region."),
+		schemaKeyTimeKeyName:      optionalString("The name of the partition key holding the time."),
+		schemaKeyTimeDayKeyName:   optionalString("The name of the partition key holding the day."),
+		schemaKeyTimeHourKeyName:  optionalString("The name of the partition key holding the hour."),
+		schemaKeyTimeMonthKeyName: optionalString("The name of the partition key holding the month."),
+		schemaKeyTimeRange:        optionalString("The time range of the projected partitions."),
+		schemaKeyTimeUnit:         optionalString("The time unit of the projected partitions."),
+	}
+}
+
+func extraSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyColumnIndexes: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Description: "The indexes of the columns to read.",
+		},
+		schemaKeyOrgID:          optionalString("The organization ID of the source."),
+		schemaKeyPartitionStyle: optionalString("The partition style of the location."),
+	}
+}
+
+func managedGlueResourcesResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyManagedGlueResource: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyCloudProvider:  requiredString("The cloud provider of the location, for example `aws`."),
+					schemaKeyDatabase:       requiredString("The name of the Glue database."),
+					schemaKeyTable:          requiredString("The name of the Glue table."),
+					schemaKeyLocationPrefix: requiredString("The location prefix of the data, for example `s3://bucket/prefix/`."),
+					schemaKeyFileFormat:     requiredString("The file format of the data."),
+					schemaKeySourceType:     requiredString("The source type of the data."),
+					schemaKeyFieldDelimiter: optionalString("The field delimiter of delimited file formats."),
+					schemaKeyPartitionProjection: {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: partitionProjectionSchema(),
+						},
+						Description: "The partition projection of the table.",
+					},
+					schemaKeyExtra: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Computed: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: extraSchema(),
+						},
+						Description: "Extra settings of the table.",
+					},
+				},
+			},
+			Description: "The managed glue resources of the stack. Every managed glue resource of the stack is managed, " +
+				"entries removed from the list are removed from the stack.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the managed glue resources as returned by ACS.",
+		},
+	}
+}
+
+func ResourceManagedGlueResources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Managed Glue Resources Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/FederatedSearch/fss3 " +
+			"for more latest, detailed information on attribute requirements and the ACS Managed Glue Resources API.",
+
+		CreateContext: resourceManagedGlueResourcesCreate,
+		ReadContext:   resourceManagedGlueResourcesRead,
+		UpdateContext: resourceManagedGlueResourcesUpdate,
+		DeleteContext: resourceManagedGlueResourcesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: managedGlueResourcesResourceSchema(),
+	}
+}
+
+func resourceManagedGlueResourcesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	glueResources := ExpandGlueResources(d.Get(schemaKeyManagedGlueResource).([]interface{}))
+
+	if diags := updateGlueResources(ctx, acsClient, stack, glueResources); diags != nil {
+		return diags
+	}
+
+	// The managed glue resources are a single list per stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created managed glue resources resource: %s\n", stack))
+
+	// Call read to set attributes of managed glue resources
+	return resourceManagedGlueResourcesRead(ctx, d, m)
+}
+
+func resourceManagedGlueResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	glueResources, err := WaitGlueResourcesRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading managed glue resources: %s", err)
+	}
+
+	var entries []v2.ManagedGlueResources
+	if glueResources.ManagedGlueResources != nil {
+		entries = *glueResources.ManagedGlueResources
+	}
+
+	if err := d.Set(schemaKeyManagedGlueResource, FlattenGlueResources(entries)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStatus, glueResources.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceManagedGlueResourcesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyManagedGlueResource) {
+		glueResources := ExpandGlueResources(d.Get(schemaKeyManagedGlueResource).([]interface{}))
+		if diags := updateGlueResources(ctx, acsClient, stack, glueResources); diags != nil {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated managed glue resources resource: %s\n", d.Id()))
+	}
+
+	return resourceManagedGlueResourcesRead(ctx, d, m)
+}
+
+func resourceManagedGlueResourcesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Replace the list with an empty list to remove every managed glue resource
+	if diags := updateGlueResources(ctx, acsClient, stack, []v2.ManagedGlueResources{}); diags != nil {
+		return diags
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted managed glue resources resource: %s\n", d.Id()))
+	return nil
+}
+
+// updateGlueResources replaces the managed glue resources of the stack and polls the status until the update is applied
+func updateGlueResources(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) diag.Diagnostics {
+	if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitGlueResourcesUpdate(ctx, acsClient, stack, glueResources)
+	}); err != nil {
+		return diag.Errorf("Error submitting request for managed glue resources to be updated: %s", err)
+	}
+
+	if err := WaitGlueResourcesApplied(ctx, acsClient, stack, glueResources); err != nil {
+		return diag.Errorf("Error waiting for managed glue resources to be applied: %s", err)
+	}
+	return nil
+}
+
+// ExpandGlueResources converts the managed_glue_resource blocks to the ACS request model
+func ExpandGlueResources(rawEntries []interface{}) []v2.ManagedGlueResources {
+	glueResources := make([]v2.ManagedGlueResources, 0, len(rawEntries))
+	for _, rawEntry := range rawEntries {
+		entry := rawEntry.(map[string]interface{})
+
+		glueResource := v2.ManagedGlueResources{
+			CloudProvider:  entry[schemaKeyCloudProvider].(string),
+			Database:       entry[schemaKeyDatabase].(string),
+			Table:          entry[schemaKeyTable].(string),
+			LocationPrefix: entry[schemaKeyLocationPrefix].(string),
+			FileFormat:     entry[schemaKeyFileFormat].(string),
+			SourceType:     entry[schemaKeySourceType].(string),
+			FieldDelimiter: optionalValue(entry[schemaKeyFieldDelimiter]),
+		}
+
+		if rawProjection, ok := entry[schemaKeyPartitionProjection].([]interface{}); ok && len(rawProjection) > 0 && rawProjection[0] != nil {
+			projection := rawProjection[0].(map[string]interface{})
+			glueResource.PartitionProjection = v2.PartitionProjection{
+				AccountIDs:       expandStrings(projection[schemaKeyAccountIDs]),
+				Regions:          expandStrings(projection[schemaKeyRegions]),
+				AccountIDKeyName: optionalValue(projection[schemaKeyAccountIDKeyName]),
+				RegionKeyName:    optionalValue(projection[schemaKeyRegionKeyName]),
+				TimeKeyName:      optionalValue(projection[schemaKeyTimeKeyName]),
+				TimeDayKeyName:   optionalValue(projection[schemaKeyTimeDayKeyName]),
+				TimeHourKeyName:  optionalValue(projection[schemaKeyTimeHourKeyName]),
+				TimeMonthKeyName: optionalValue(projection[schemaKeyTimeMonthKeyName]),
+				TimeRange:        optionalValue(projection[schemaKeyTimeRange]),
+				TimeUnit:         optionalValue(projection[schemaKeyTimeUnit]),
+			}
+		}
+
+		if rawExtra, ok := entry[schemaKeyExtra].([]interface{}); ok && len(rawExtra) > 0 && rawExtra[0] != nil {
+			extra := rawExtra[0].(map[string]interface{})
+			glueExtra := v2.Extra{
+				OrgID:          optionalValue(extra[schemaKeyOrgID]),
+				PartitionStyle: optionalValue(extra[schemaKeyPartitionStyle]),
+			}
+			if rawIndexes, ok := extra[schemaKeyColumnIndexes].([]interface{}); ok && len(rawIndexes) > 0 {
+				columnIndexes := make([]int, 0, len(rawIndexes))
+				for _, rawIndex := range rawIndexes {
+					columnIndexes = append(columnIndexes, rawIndex.(int))
+				}
+				glueExtra.ColumnIndexes = &columnIndexes
+			}
+			glueResource.Extra = &glueExtra
+		}
+
+		glueResources = append(glueResources, glueResource)
+	}
+	return glueResources
+}
+
+// FlattenGlueResources converts the ACS model to managed_glue_resource blocks
+func FlattenGlueResources(glueResources []v2.ManagedGlueResources) []interface{} {
+	flattened := make([]interface{}, 0, len(glueResources))
+	for _, glueResource := range glueResources {
+		projection := glueResource.PartitionProjection
+		entry := map[string]interface{}{
+			schemaKeyCloudProvider:  glueResource.CloudProvider,
+			schemaKeyDatabase:       glueResource.Database,
+			schemaKeyTable:          glueResource.Table,
+			schemaKeyLocationPrefix: glueResource.LocationPrefix,
+			schemaKeyFileFormat:     glueResource.FileFormat,
+			schemaKeySourceType:     glueResource.SourceType,
+			schemaKeyFieldDelimiter: glueResource.FieldDelimiter,
+			schemaKeyPartitionProjection: []interface{}{
+				map[string]interface{}{
+					schemaKeyAccountIDs:       projection.AccountIDs,
+					schemaKeyRegions:          projection.Regions,
+					schemaKeyAccountIDKeyName: projection.AccountIDKeyName,
+					schemaKeyRegionKeyName:    projection.RegionKeyName,
+					schemaKeyTimeKeyName:      projection.TimeKeyName,
+					schemaKeyTimeDayKeyName:   projection.TimeDayKeyName,
+					schemaKeyTimeHourKeyName:  projection.TimeHourKeyName,
+					schemaKeyTimeMonthKeyName: projection.TimeMonthKeyName,
+					schemaKeyTimeRange:        projection.TimeRange,
+					schemaKeyTimeUnit:         projection.TimeUnit,
+				},
+			},
+		}
+
+		if glueResource.Extra != nil {
+			var columnIndexes []int
+			if glueResource.Extra.ColumnIndexes != nil {
+				columnIndexes = *glueResource.Extra.ColumnIndexes
+			}
+			entry[schemaKeyExtra] = []interface{}{
+				map[string]interface{}{
+					schemaKeyColumnIndexes:  columnIndexes,
+					schemaKeyOrgID:          glueResource.Extra.OrgID,
+					schemaKeyPartitionStyle: glueResource.Extra.PartitionStyle,
+				},
+			}
+		}
+
+		flattened = append(flattened, entry)
+	}
+	return flattened
+}
+
+func optionalValue(rawValue interface{}) *string {
+	value, ok := rawValue.(string)
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
+
+func expandStrings(rawValues interface{}) []string {
+	values := make([]string, 0)
+	rawList, ok := rawValues.([]interface{})
+	if !ok {
+		return values
+	}
+	for _, rawValue := range rawList {
+		if value, ok := rawValue.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,69 @@
+package managedglue_test
+
+import (
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/managedglue"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR MANAGED GLUE RESOURCES RESOURCE
+
+Managed glue resources point at an S3 bucket and Glue catalog in a customer AWS account connected to the stack for
+federated search, and ACS rejects locations it can not reach, so the resource can not be applied on the acceptance test
+stack without such an account.
+*/
+
+func Test_ExpandGlueResources(t *testing.T) {
+	orgID := "o-1234"
+	columnIndexes := []int{0, 2}
+	expected := mockGlueResource
+	expected.Extra = &v2.Extra{ColumnIndexes: &columnIndexes, OrgID: &orgID}
+
+	expanded := managedglue.ExpandGlueResources([]interface{}{
+		map[string]interface{}{
+			"cloud_provider":  "aws",
+			"database":        "mock_database",
+			"table":           mockTable,
+			"location_prefix": "s3://mock-bucket/logs/",
+			"file_format":     "parquet",
+			"source_type":     "aws:cloudtrail",
+			"field_delimiter": "",
+			"partition_projection": []interface{}{
+				map[string]interface{}{
+					"account_ids":   []interface{}{"123456789012"},
+					"regions":       []interface{}{"us-east-1"},
+					"time_key_name": "",
+				},
+			},
+			"extra": []interface{}{
+				map[string]interface{}{
+					"column_indexes":  []interface{}{0, 2},
+					"org_id":          orgID,
+					"partition_style": "",
+				},
+			},
+		},
+	})
+	assert.Equal(t, []v2.ManagedGlueResources{expected}, expanded)
+}
+
+func Test_FlattenGlueResources(t *testing.T) {
+	flattened := managedglue.FlattenGlueResources([]v2.ManagedGlueResources{mockGlueResource})
+	assert.Len(t, flattened, 1)
+
+	entry := flattened[0].(map[string]interface{})
+	assert.Equal(t, mockTable, entry["table"])
+	assert.NotContains(t, entry, "extra")
+
+	projection := entry["partition_projection"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, []string{"us-east-1"}, projection["regions"])
+}
+
+func Test_ExpandGlueResourcesEmpty(t *testing.T) {
+	expanded := managedglue.ExpandGlueResources([]interface{}{})
+	assert.NotNil(t, expanded)
+	assert.Empty(t, expanded)
+}
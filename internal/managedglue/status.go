@@ -0,0 +1,151 @@
+package managedglue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// GlueResourcesPendingStatus is returned while ACS is still applying the managed glue resources
+	GlueResourcesPendingStatus = "GLUE_RESOURCES_PENDING"
+	GlueResourcesAppliedStatus = "GLUE_RESOURCES_APPLIED"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+var (
+	// glueInProgressStatuses are the normalized statuses returned while an update is still being applied
+	glueInProgressStatuses = map[string]bool{"pending": true, "inprogress": true, "updating": true, "provisioning": true, "running": true}
+	// glueFailedStatuses are the normalized statuses returned when an update could not be applied
+	glueFailedStatuses = map[string]bool{"failed": true, "error": true}
+)
+
+// GlueResourcesStatusUpdate returns StateRefreshFunc that makes PUT request and checks if request was accepted
+func GlueResourcesStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		updateRequest := v2.UpdateManagedGlueResourcesJSONRequestBody{
+			ManagedGlueResources: &glueResources,
+		}
+		resp, err := acsClient.UpdateManagedGlueResources(ctx, stack, updateRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// GlueResourcesStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the managed glue resources
+func GlueResourcesStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeManagedGlueResources(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var glueResources v2.DescribeManagedGlueResources
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &glueResources); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &glueResources, status, nil
+	}
+}
+
+// GlueResourcesStatusApplied returns StateRefreshFunc that makes GET request and checks if the last update has been
+// applied, errors if the update failed. The update is applied once the returned status is neither failed nor in progress
+// and the returned managed glue resources match the requested ones, so an empty status does not block the wait
+func GlueResourcesStatusApplied(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := GlueResourcesStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+
+		describedGlueResources := output.(*v2.DescribeManagedGlueResources)
+		var glueStatus string
+		if describedGlueResources.Status != nil {
+			glueStatus = *describedGlueResources.Status
+		}
+
+		normalized := normalizeGlueStatus(glueStatus)
+		if glueFailedStatuses[normalized] {
+			return nil, GlueResourcesPendingStatus, fmt.Errorf("managed glue resources update failed with status (%s)", glueStatus)
+		}
+		if glueInProgressStatuses[normalized] {
+			return describedGlueResources, GlueResourcesPendingStatus, nil
+		}
+
+		var entries []v2.ManagedGlueResources
+		if describedGlueResources.ManagedGlueResources != nil {
+			entries = *describedGlueResources.ManagedGlueResources
+		}
+		if !MatchGlueResources(entries, glueResources) {
+			return describedGlueResources, GlueResourcesPendingStatus, nil
+		}
+		return describedGlueResources, GlueResourcesAppliedStatus, nil
+	}
+}
+
+// MatchGlueResources returns true if the returned managed glue resources are the requested ones regardless of their order.
+// Entries are compared by the fields ACS returns as submitted, optional fields may be defaulted by ACS and are not compared
+func MatchGlueResources(actual []v2.ManagedGlueResources, expected []v2.ManagedGlueResources) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	remaining := make(map[string]int, len(expected))
+	for _, glueResource := range expected {
+		remaining[glueResourceKey(glueResource)]++
+	}
+	for _, glueResource := range actual {
+		key := glueResourceKey(glueResource)
+		if remaining[key] == 0 {
+			return false
+		}
+		remaining[key]--
+	}
+	return true
+}
+
+// glueResourceKey joins the required fields of a managed glue resource
+func glueResourceKey(glueResource v2.ManagedGlueResources) string {
+	return strings.Join([]string{
+		glueResource.CloudProvider,
+		glueResource.Database,
+		glueResource.Table,
+		glueResource.LocationPrefix,
+		glueResource.FileFormat,
+		glueResource.SourceType,
+	}, "\x00")
+}
+
+// normalizeGlueStatus lower cases the status and strips separators so that IN_PROGRESS, In-Progress and InProgress match
+func normalizeGlueStatus(glueStatus string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(glueStatus))
+}
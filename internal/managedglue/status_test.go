@@ -0,0 +1,184 @@
+package managedglue_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/managedglue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_GlueResourcesStatusUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(req v2.UpdateManagedGlueResourcesJSONRequestBody) bool {
+			return req.ManagedGlueResources != nil && len(*req.ManagedGlueResources) == 1 && (*req.ManagedGlueResources)[0].Table == mockTable
+		})).Return(genGlueResp(http.StatusAccepted, nil), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusUpdate(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := managedglue.GlueResourcesStatusUpdate(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{})()
+		assert.Error(t, err)
+	})
+}
+
+func Test_GlueResourcesStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("Applied")), nil).Once()
+		output, statusText, err := managedglue.GlueResourcesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockTable, (*output.(*v2.DescribeManagedGlueResources).ManagedGlueResources)[0].Table)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusBadRequest, nil), nil).Once()
+		output, statusText, err := managedglue.GlueResourcesStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), statusText)
+	})
+}
+
+func Test_GlueResourcesStatusApplied(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with applied status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("Applied")), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesAppliedStatus, statusText)
+	})
+
+	t.Run("with in progress status", func(t *testing.T) {
+		for _, glueStatus := range []string{"IN_PROGRESS", "In-Progress", "pending", "Updating"} {
+			client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue(glueStatus)), nil).Once()
+			_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+			assert.NoError(t, err)
+			assert.Equal(t, managedglue.GlueResourcesPendingStatus, statusText)
+		}
+	})
+
+	t.Run("with empty status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("")), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesAppliedStatus, statusText)
+	})
+
+	t.Run("with unknown status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("VALIDATED")), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesAppliedStatus, statusText)
+	})
+
+	t.Run("with empty status and previous glue resources", func(t *testing.T) {
+		requested := mockGlueResource
+		requested.Table = "other_table"
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("")), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{requested})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesPendingStatus, statusText)
+	})
+
+	t.Run("with delete to empty list and no status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, v2.DescribeManagedGlueResources{
+			ManagedGlueResources: &[]v2.ManagedGlueResources{},
+		}), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesAppliedStatus, statusText)
+	})
+
+	t.Run("with delete to empty list still in progress", func(t *testing.T) {
+		glueStatus := "IN_PROGRESS"
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, v2.DescribeManagedGlueResources{
+			ManagedGlueResources: &[]v2.ManagedGlueResources{},
+			Status:               &glueStatus,
+		}), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesPendingStatus, statusText)
+	})
+
+	t.Run("with applied status and previous glue resources", func(t *testing.T) {
+		requested := mockGlueResource
+		requested.Table = "other_table"
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("Applied")), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{requested})()
+		assert.NoError(t, err)
+		assert.Equal(t, managedglue.GlueResourcesPendingStatus, statusText)
+	})
+
+	t.Run("with failed status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusOK, genDescribeGlue("FAILED")), nil).Once()
+		_, _, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := managedglue.GlueResourcesStatusApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+}
+
+func Test_MatchGlueResources(t *testing.T) {
+	other := mockGlueResource
+	other.Table = "other_table"
+
+	assert.True(t, managedglue.MatchGlueResources([]v2.ManagedGlueResources{mockGlueResource, other}, []v2.ManagedGlueResources{other, mockGlueResource}))
+	assert.True(t, managedglue.MatchGlueResources(nil, []v2.ManagedGlueResources{}))
+	assert.False(t, managedglue.MatchGlueResources([]v2.ManagedGlueResources{mockGlueResource}, []v2.ManagedGlueResources{}))
+	assert.False(t, managedglue.MatchGlueResources([]v2.ManagedGlueResources{mockGlueResource}, []v2.ManagedGlueResources{other}))
+	assert.False(t, managedglue.MatchGlueResources([]v2.ManagedGlueResources{mockGlueResource, mockGlueResource}, []v2.ManagedGlueResources{mockGlueResource, other}))
+}
+
+func genDescribeGlue(glueStatus string) v2.DescribeManagedGlueResources {
+	return v2.DescribeManagedGlueResources{
+		ManagedGlueResources: &[]v2.ManagedGlueResources{mockGlueResource},
+		Status:               &glueStatus,
+	}
+}
+
+func genGlueResp(code int, body interface{}) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(body)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,63 @@
+package managedglue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	PendingStatusGlueResourcesApplied = []string{GlueResourcesPendingStatus, http.StatusText(http.StatusTooManyRequests)}
+	TargetStatusGlueResourcesApplied  = []string{GlueResourcesAppliedStatus}
+)
+
+// WaitGlueResourcesUpdate Handles retry logic for PUT requests for the create/update/delete lifecycle functions
+func WaitGlueResourcesUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) error {
+	waitGlueResourcesUpdateAccepted := wait.GenerateWriteStateChangeConf(GlueResourcesStatusUpdate(ctx, acsClient, stack, glueResources))
+
+	rawResp, err := waitGlueResourcesUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for managed glue resources to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for managed glue resources: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for managed glue resources: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitGlueResourcesApplied Handles retry logic for polling the managed glue resources status until the requested
+// managed glue resources have been applied
+func WaitGlueResourcesApplied(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, glueResources []v2.ManagedGlueResources) error {
+	waitGlueResourcesApplied := wait.GenerateReadStateChangeConf(PendingStatusGlueResourcesApplied, TargetStatusGlueResourcesApplied, GlueResourcesStatusApplied(ctx, acsClient, stack, glueResources))
+
+	_, err := waitGlueResourcesApplied.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for managed glue resources to be applied: %s", err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitGlueResourcesRead Handles retry logic for GET requests for the read lifecycle function
+func WaitGlueResourcesRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.DescribeManagedGlueResources, error) {
+	waitGlueResourcesRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, GlueResourcesStatusRead(ctx, acsClient, stack))
+
+	output, err := waitGlueResourcesRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading managed glue resources: %s", err))
+		return nil, err
+	}
+	glueResources := output.(*v2.DescribeManagedGlueResources)
+
+	return glueResources, nil
+}
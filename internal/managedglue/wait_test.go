@@ -0,0 +1,103 @@
+package managedglue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/managedglue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+	mockTable = "mock_table"
+)
+
+var mockGlueResource = v2.ManagedGlueResources{
+	CloudProvider:  "aws",
+	Database:       "mock_database",
+	Table:          mockTable,
+	LocationPrefix: "s3://mock-bucket/logs/",
+	FileFormat:     "parquet",
+	SourceType:     "aws:cloudtrail",
+	PartitionProjection: v2.PartitionProjection{
+		AccountIDs: []string{"123456789012"},
+		Regions:    []string{"us-east-1"},
+	},
+}
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitGlueResourcesUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := managedglue.WaitGlueResourcesUpdate(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genGlueResp(429, nil), nil).Once()
+		client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genGlueResp(202, nil), nil).Once()
+		err := managedglue.WaitGlueResourcesUpdate(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("UpdateManagedGlueResources", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genGlueResp(statusCode, nil), nil).Once()
+				err := managedglue.WaitGlueResourcesUpdate(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitGlueResourcesApplied(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with status in progress then applied", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(200, genDescribeGlue("IN_PROGRESS")), nil).Once()
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(200, genDescribeGlue("APPLIED")), nil).Once()
+		err := managedglue.WaitGlueResourcesApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})
+		assert.NoError(t, err)
+	})
+
+	t.Run("with failed status", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(200, genDescribeGlue("FAILED")), nil).Once()
+		err := managedglue.WaitGlueResourcesApplied(context.TODO(), client, v2.Stack(mockStack), []v2.ManagedGlueResources{mockGlueResource})
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitGlueResourcesRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(429, nil), nil).Once()
+		client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(200, genDescribeGlue("APPLIED")), nil).Once()
+		glueResources, err := managedglue.WaitGlueResourcesRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.Equal(t, mockTable, (*glueResources.ManagedGlueResources)[0].Table)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeManagedGlueResources", mock.Anything, v2.Stack(mockStack)).Return(genGlueResp(statusCode, nil), nil).Once()
+				_, err := managedglue.WaitGlueResourcesRead(context.TODO(), client, v2.Stack(mockStack))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
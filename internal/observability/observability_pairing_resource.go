@@ -0,0 +1,146 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_observability_pairing"
+
+	schemaKeyRealm      = "realm"
+	schemaKeyAdminToken = "admin_token"
+	schemaKeyPairingID  = "pairing_id"
+	schemaKeyStatus     = "status"
+)
+
+func observabilityPairingResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyRealm: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "The realm of the Splunk Observability Cloud organization to pair the stack with, for example `us1`. " +
+				"Can not be updated after creation.",
+		},
+		schemaKeyAdminToken: {
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "An admin access token of the Splunk Observability Cloud organization. Used to create the pairing " +
+				"and to read its status, updating the token does not pair the stack again.",
+		},
+		schemaKeyPairingID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the pairing between the stack and the Observability organization.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the pairing as returned by ACS.",
+		},
+	}
+}
+
+func ResourceObservabilityPairing() *schema.Resource {
+	return &schema.Resource{
+		Description: "Observability Pairing Resource. Pairs the stack with a Splunk Observability Cloud organization. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageObservability " +
+			"for more latest, detailed information on attribute requirements and the ACS Observability API.",
+
+		CreateContext: resourceObservabilityPairingCreate,
+		ReadContext:   resourceObservabilityPairingRead,
+		UpdateContext: resourceObservabilityPairingUpdate,
+		DeleteContext: resourceObservabilityPairingDelete,
+
+		Schema: observabilityPairingResourceSchema(),
+	}
+}
+
+func resourceObservabilityPairingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	realm := d.Get(schemaKeyRealm).(string)
+	adminToken := d.Get(schemaKeyAdminToken).(string)
+
+	var pairingID string
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() (err error) {
+		pairingID, err = WaitPairingCreate(ctx, acsClient, stack, realm, adminToken)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for observability pairing with realm (%s): %s", realm, err)
+	}
+
+	// Poll the pairing status until the pairing has completed
+	if _, err = WaitPairingComplete(ctx, acsClient, stack, pairingID, realm, adminToken); err != nil {
+		return diag.Errorf("Error waiting for observability pairing (%s) with realm (%s) to complete: %s", pairingID, realm, err)
+	}
+
+	// Set ID of pairing resource to the pairing ID to indicate the stack has been paired
+	d.SetId(pairingID)
+	tflog.Info(ctx, fmt.Sprintf("Created observability pairing resource: %s\n", pairingID))
+
+	// Call read to set attributes of pairing
+	return resourceObservabilityPairingRead(ctx, d, m)
+}
+
+func resourceObservabilityPairingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pairingID := d.Id()
+	realm := d.Get(schemaKeyRealm).(string)
+	adminToken := d.Get(schemaKeyAdminToken).(string)
+
+	pairingStatus, err := WaitPairingRead(ctx, acsClient, stack, pairingID, realm, adminToken)
+	if err != nil {
+		// if pairing not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing observability pairing from state. Not Found error while reading observability pairing (%s): %s.", pairingID, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading observability pairing (%s): %s", pairingID, err)
+	}
+
+	if err := d.Set(schemaKeyPairingID, pairingID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStatus, pairingStatus.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceObservabilityPairingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only admin_token can be updated in place, it is used for the next status reads
+	tflog.Info(ctx, fmt.Sprintf("Updated admin token of observability pairing resource: %s\n", d.Id()))
+	return resourceObservabilityPairingRead(ctx, d, m)
+}
+
+func resourceObservabilityPairingDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support removing an observability pairing, the pairing is only removed from terraform state
+	tflog.Warn(ctx, fmt.Sprintf("Observability pairing (%s) removed from state only. ACS does not support removing observability pairings, "+
+		"please contact Splunk support to unpair the stack.", d.Id()))
+	d.SetId("")
+	return nil
+}
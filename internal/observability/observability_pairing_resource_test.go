@@ -0,0 +1,78 @@
+package observability_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR OBSERVABILITY PAIRING RESOURCE
+
+Pairing requires the admin access token of a Splunk Observability Cloud organization, which the acceptance test
+environment does not provide, and ACS does not support removing a pairing once created. The create and read flows are
+covered against a mocked client below.
+*/
+
+func Test_ResourceObservabilityPairingCreate(t *testing.T) {
+	t.Run("with pairing in progress then success", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(http.StatusCreated, v2.CreateEcSsoPairingResponse{PairingId: &mockPairingIDValue}), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("IN_PROGRESS")), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("SUCCESS")), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("SUCCESS")), nil).Once()
+
+		d := genResourceData(t)
+		diags := observability.ResourceObservabilityPairing().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, mockPairingID, d.Id())
+		assert.Equal(t, mockPairingID, d.Get("pairing_id"))
+		assert.Equal(t, "SUCCESS", d.Get("status"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with failed pairing", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(http.StatusCreated, v2.CreateEcSsoPairingResponse{PairingId: &mockPairingIDValue}), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("FAILED")), nil).Once()
+
+		d := genResourceData(t)
+		diags := observability.ResourceObservabilityPairing().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.True(t, diags.HasError())
+		assert.Empty(t, d.Id())
+		client.AssertExpectations(t)
+	})
+}
+
+func Test_ResourceObservabilityPairingRead(t *testing.T) {
+	t.Run("with pairing not found", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusNotFound, nil), nil).Once()
+
+		d := genResourceData(t)
+		d.SetId(mockPairingID)
+		diags := observability.ResourceObservabilityPairing().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, d.Id())
+		client.AssertExpectations(t)
+	})
+}
+
+func genResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, observability.ResourceObservabilityPairing().Schema, map[string]interface{}{
+		"realm":       mockRealm,
+		"admin_token": mockAdminToken,
+	})
+}
+
+func genACSProvider(acsClient v2.ClientInterface) client.ACSProvider {
+	return client.ACSProvider{Client: &acsClient, Stack: v2.Stack(mockStack)}
+}
@@ -0,0 +1,122 @@
+package observability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// PairingPendingStatus is returned while the pairing with the Observability organization is in progress
+	PairingPendingStatus  = "PAIRING_PENDING"
+	PairingCompleteStatus = "PAIRING_COMPLETE"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+var (
+	// pairingCompleteStatuses are the normalized statuses returned once the stack is paired
+	pairingCompleteStatuses = map[string]bool{"success": true, "succeeded": true, "complete": true, "completed": true, "paired": true}
+	// pairingFailedStatuses are the normalized statuses returned when the pairing could not be completed
+	pairingFailedStatuses = map[string]bool{"failed": true, "failure": true, "error": true}
+)
+
+// PairingStatusCreate returns StateRefreshFunc that makes POST request, checks if request was accepted, and returns the pairing ID
+func PairingStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, adminToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		params := v2.PostObservabilityPairingParams{O11yAccessToken: adminToken}
+		resp, err := acsClient.PostObservabilityPairing(ctx, string(stack), &params, v2.PostObservabilityPairingJSONRequestBody{O11yRealm: &realm})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+		_, statusText, statusErr := status.ProcessResponse(resp, TargetStatusPairingCreated, wait.PendingStatusCRUD)
+		if statusErr != nil {
+			return nil, statusText, statusErr
+		}
+
+		var pairing v2.CreateEcSsoPairingResponse
+		if resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &pairing); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &pairing, statusText, nil
+	}
+}
+
+// PairingStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the pairing status
+func PairingStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, adminToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		params := v2.GetObservabilityPairingStatusParams{O11yRealm: &realm, O11yAccessToken: adminToken}
+		resp, err := acsClient.GetObservabilityPairingStatus(ctx, string(stack), pairingID, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var pairingStatus v2.GetEcSsoPairingStatusResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &pairingStatus); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &pairingStatus, status, nil
+	}
+}
+
+// PairingStatusComplete returns StateRefreshFunc that makes GET request and checks if the pairing has completed,
+// errors if the pairing failed
+func PairingStatusComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, adminToken string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := PairingStatusRead(ctx, acsClient, stack, pairingID, realm, adminToken)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, err
+		}
+
+		pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+		var rawStatus string
+		if pairingStatus.Status != nil {
+			rawStatus = *pairingStatus.Status
+		}
+
+		normalized := normalizePairingStatus(rawStatus)
+		if pairingFailedStatuses[normalized] {
+			return nil, PairingPendingStatus, fmt.Errorf("observability pairing (%s) failed with status (%s)", pairingID, rawStatus)
+		}
+		if pairingCompleteStatuses[normalized] {
+			return pairingStatus, PairingCompleteStatus, nil
+		}
+		return pairingStatus, PairingPendingStatus, nil
+	}
+}
+
+// normalizePairingStatus lower cases the status and strips separators so that IN_PROGRESS, In-Progress and InProgress match
+func normalizePairingStatus(pairingStatus string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(pairingStatus))
+}
@@ -0,0 +1,114 @@
+package observability_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_PairingStatusCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 201 response", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, &v2.PostObservabilityPairingParams{O11yAccessToken: mockAdminToken}, mock.MatchedBy(func(body v2.PostObservabilityPairingJSONRequestBody) bool {
+			return body.O11yRealm != nil && *body.O11yRealm == mockRealm
+		})).Return(genPairingResp(http.StatusCreated, v2.CreateEcSsoPairingResponse{PairingId: &mockPairingIDValue}), nil).Once()
+		output, statusText, err := observability.PairingStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusCreated), statusText)
+		assert.Equal(t, mockPairingID, *output.(*v2.CreateEcSsoPairingResponse).PairingId)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := observability.PairingStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_PairingStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.MatchedBy(func(params *v2.GetObservabilityPairingStatusParams) bool {
+			return params != nil && params.O11yAccessToken == mockAdminToken && params.O11yRealm != nil && *params.O11yRealm == mockRealm
+		})).Return(genPairingResp(http.StatusOK, genPairingStatus("SUCCESS")), nil).Once()
+		output, statusText, err := observability.PairingStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, "SUCCESS", *output.(*v2.GetEcSsoPairingStatusResponse).Status)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := observability.PairingStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusUnauthorized, nil), nil).Once()
+		output, statusText, err := observability.PairingStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusUnauthorized), statusText)
+	})
+}
+
+func Test_PairingStatusComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with complete status", func(t *testing.T) {
+		for _, pairingStatus := range []string{"SUCCESS", "Completed", "paired"} {
+			client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus(pairingStatus)), nil).Once()
+			_, statusText, err := observability.PairingStatusComplete(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+			assert.NoError(t, err)
+			assert.Equal(t, observability.PairingCompleteStatus, statusText)
+		}
+	})
+
+	t.Run("with in progress status", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("IN_PROGRESS")), nil).Once()
+		_, statusText, err := observability.PairingStatusComplete(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+		assert.NoError(t, err)
+		assert.Equal(t, observability.PairingPendingStatus, statusText)
+	})
+
+	t.Run("with failed status", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(http.StatusOK, genPairingStatus("FAILED")), nil).Once()
+		_, _, err := observability.PairingStatusComplete(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)()
+		assert.Error(t, err)
+	})
+}
+
+func genPairingStatus(pairingStatus string) v2.GetEcSsoPairingStatusResponse {
+	return v2.GetEcSsoPairingStatusResponse{PairingId: &mockPairingIDValue, Status: &pairingStatus}
+}
+
+func genPairingResp(code int, body interface{}) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusCreated {
+		b, _ = json.Marshal(body)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,68 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// TargetStatusPairingCreated ACS returns 201 once the pairing request is accepted
+	TargetStatusPairingCreated = []string{http.StatusText(http.StatusCreated), http.StatusText(http.StatusAccepted), http.StatusText(http.StatusOK)}
+
+	PendingStatusPairingComplete = []string{PairingPendingStatus, http.StatusText(http.StatusTooManyRequests)}
+	TargetStatusPairingComplete  = []string{PairingCompleteStatus}
+)
+
+// WaitPairingCreate Handles retry logic for POST requests for create lifecycle function, returns the pairing ID
+func WaitPairingCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, realm string, adminToken string) (string, error) {
+	waitPairingCreateAccepted := wait.GenerateWriteStateChangeConf(PairingStatusCreate(ctx, acsClient, stack, realm, adminToken))
+	waitPairingCreateAccepted.Target = TargetStatusPairingCreated
+
+	output, err := waitPairingCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for observability pairing with realm (%s): %s", realm, err))
+		return "", err
+	}
+
+	pairing := output.(*v2.CreateEcSsoPairingResponse)
+	if pairing.PairingId == nil || *pairing.PairingId == "" {
+		return "", fmt.Errorf("ACS did not return a pairing ID for realm (%s)", realm)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Pairing request accepted for realm (%s), pairing ID: %s\n", realm, *pairing.PairingId))
+
+	return *pairing.PairingId, nil
+}
+
+// WaitPairingComplete Handles retry logic for polling the pairing status until the pairing has completed
+func WaitPairingComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, adminToken string) (*v2.GetEcSsoPairingStatusResponse, error) {
+	waitPairingComplete := wait.GenerateReadStateChangeConf(PendingStatusPairingComplete, TargetStatusPairingComplete, PairingStatusComplete(ctx, acsClient, stack, pairingID, realm, adminToken))
+
+	output, err := waitPairingComplete.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for observability pairing (%s) to complete: %s", pairingID, err))
+		return nil, err
+	}
+	pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+
+	return pairingStatus, nil
+}
+
+// WaitPairingRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPairingRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pairingID string, realm string, adminToken string) (*v2.GetEcSsoPairingStatusResponse, error) {
+	waitPairingRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PairingStatusRead(ctx, acsClient, stack, pairingID, realm, adminToken))
+
+	output, err := waitPairingRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading observability pairing (%s): %s", pairingID, err))
+		return nil, err
+	}
+	pairingStatus := output.(*v2.GetEcSsoPairingStatusResponse)
+
+	return pairingStatus, nil
+}
@@ -0,0 +1,94 @@
+package observability_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack      = "mock-stack"
+	mockRealm      = "us1"
+	mockAdminToken = "mock-admin-token"
+	mockPairingID  = "mock-pairing-id"
+)
+
+var mockPairingIDValue = mockPairingID
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitPairingCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, err := observability.WaitPairingCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(429, nil), nil).Once()
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(201, v2.CreateEcSsoPairingResponse{PairingId: &mockPairingIDValue}), nil).Once()
+		pairingID, err := observability.WaitPairingCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)
+		assert.NoError(t, err)
+		assert.Equal(t, mockPairingID, pairingID)
+	})
+
+	t.Run("with missing pairing ID", func(t *testing.T) {
+		client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(201, v2.CreateEcSsoPairingResponse{}), nil).Once()
+		_, err := observability.WaitPairingCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("PostObservabilityPairing", mock.Anything, mockStack, mock.Anything, mock.Anything).Return(genPairingResp(statusCode, nil), nil).Once()
+				_, err := observability.WaitPairingCreate(context.TODO(), client, v2.Stack(mockStack), mockRealm, mockAdminToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPairingComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with status in progress then success", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(200, genPairingStatus("IN_PROGRESS")), nil).Once()
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(200, genPairingStatus("SUCCESS")), nil).Once()
+		pairingStatus, err := observability.WaitPairingComplete(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCESS", *pairingStatus.Status)
+	})
+
+	t.Run("with failed status", func(t *testing.T) {
+		client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(200, genPairingStatus("FAILED")), nil).Once()
+		_, err := observability.WaitPairingComplete(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitPairingRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetObservabilityPairingStatus", mock.Anything, mockStack, mockPairingID, mock.Anything).Return(genPairingResp(statusCode, nil), nil).Once()
+				_, err := observability.WaitPairingRead(context.TODO(), client, v2.Stack(mockStack), mockPairingID, mockRealm, mockAdminToken)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package outboundports
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	ResourceKey = "scp_outbound_ports"
+
+	schemaKeyPort              = "port"
+	schemaKeyDestinationRanges = "destination_ranges"
+)
+
+func outboundPortResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPort: {
+			Type:             schema.TypeInt,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+			Description: "The outbound port to open. No two resources should have the same port. Can not be updated after creation, " +
+				"if changed in config file terraform will propose a replacement (delete old outbound port and recreate with new port).",
+		},
+		schemaKeyDestinationRanges: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Destination ranges is a list of IPv4 subnets the stack is allowed to reach on the corresponding port.",
+			MinItems:    1,
+		},
+	}
+}
+
+func ResourceOutboundPort() *schema.Resource {
+	return &schema.Resource{
+		Description: "Outbound Port Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureOutboundPorts " +
+			"for more latest, detailed information on attribute requirements and the ACS Outbound Ports API.",
+
+		CreateContext: resourceOutboundPortCreate,
+		ReadContext:   resourceOutboundPortRead,
+		UpdateContext: resourceOutboundPortUpdate,
+		DeleteContext: resourceOutboundPortDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: outboundPortResourceSchema(),
+	}
+}
+
+func resourceOutboundPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Retrieve data for each field and create request body
+	port, _, newRangesSet := parseOutboundPortRequest(d)
+	addRanges := utils.GetSubnetsFromSet(newRangesSet)
+
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitOutboundPortCreate(ctx, acsClient, stack, port, addRanges)
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for outbound port (%d) to be created: %s", port, err)
+	}
+
+	// Poll outbound port until GET returns 200 to confirm creation
+	err = WaitOutboundPortPoll(ctx, acsClient, stack, port, wait.TargetStatusResourceExists, wait.PendingStatusVerifyCreated)
+	if err != nil {
+		return diag.Errorf("Error waiting for outbound port (%d) to be created: %s", port, err)
+	}
+
+	// Set ID of outbound port resource to indicate port has been created
+	d.SetId(strconv.Itoa(int(port)))
+	tflog.Info(ctx, fmt.Sprintf("Created outbound port resource: %d\n", port))
+
+	// Call read to set attributes of outbound port
+	return resourceOutboundPortRead(ctx, d, m)
+}
+
+func resourceOutboundPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	port, err := ParsePortID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outboundPort, err := WaitOutboundPortRead(ctx, acsClient, stack, port)
+	if err != nil {
+		// if outbound port not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing outbound port from state. Not Found error while reading outbound port (%d): %s.", port, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading outbound port (%d): %s", port, err)
+	}
+
+	if err := d.Set(schemaKeyPort, int(port)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyDestinationRanges, outboundPort.DestinationRanges); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOutboundPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Determine the changes to the destination ranges for a port
+	port, oldRangesSet, newRangesSet := parseOutboundPortRequest(d)
+	addRanges := utils.GetSubnetsFromSet(newRangesSet.Difference(oldRangesSet))
+	deleteRanges := utils.GetSubnetsFromSet(oldRangesSet.Difference(newRangesSet))
+
+	// Add new ranges first so the port is never left without a destination range
+	if len(addRanges) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitOutboundPortCreate(ctx, acsClient, stack, port, addRanges)
+		}); err != nil {
+			return diag.Errorf("Error updating outbound port (%d): %s", port, err)
+		}
+	}
+
+	if len(deleteRanges) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitOutboundPortDelete(ctx, acsClient, stack, port, deleteRanges)
+		}); err != nil {
+			return diag.Errorf("Error updating outbound port (%d): %s", port, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated outbound port resource: %d\n", port))
+
+	return resourceOutboundPortRead(ctx, d, m)
+}
+
+func resourceOutboundPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	port, oldRangesSet, _ := parseOutboundPortRequest(d)
+	deleteRanges := utils.GetSubnetsFromSet(oldRangesSet)
+
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitOutboundPortDelete(ctx, acsClient, stack, port, deleteRanges)
+	})
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Outbound port (%d) already removed: %s.", port, err))
+			return nil
+		}
+		return diag.Errorf("Error deleting outbound port (%d): %s", port, err)
+	}
+
+	//Poll outbound port until GET returns 404 Not found - port has been deleted
+	err = WaitOutboundPortPoll(ctx, acsClient, stack, port, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+	if err != nil {
+		return diag.Errorf("Error waiting for outbound port (%d) to be deleted: %s", port, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted outbound port resource: %d\n", port))
+	return nil
+}
+
+func parseOutboundPortRequest(d *schema.ResourceData) (port int32, oldRanges *schema.Set, newRanges *schema.Set) {
+	port = int32(d.Get(schemaKeyPort).(int))
+
+	rawOriginalRanges, rawNewRanges := d.GetChange(schemaKeyDestinationRanges)
+	oldRanges = rawOriginalRanges.(*schema.Set)
+	newRanges = rawNewRanges.(*schema.Set)
+	return port, oldRanges, newRanges
+}
+
+// ParsePortID converts the resource ID (the port number, also used for import) to a port
+func ParsePortID(id string) (int32, error) {
+	port, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid outbound port ID (%s), expected a port number between 1 and 65535", id)
+	}
+	return int32(port), nil
+}
@@ -0,0 +1,110 @@
+package outboundports_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// mockAccPort is an uncommon port with documentation-only destination ranges, so opening it does not give the
+	// acceptance test stack access to any real host
+	mockAccPort = 27017
+)
+
+func resourcePrefix(port int) string {
+	return fmt.Sprintf("scp_outbound_ports.port-%d", port)
+}
+
+func TestAcc_SplunkCloudOutboundPort_CreateUpdate(t *testing.T) {
+	// Test creating an outbound port resource and then adding and removing destination ranges
+	outboundPortTest := []resource.TestStep{
+		{
+			Config: testAccOutboundPortConfig(mockAccPort, "203.0.113.0/24"),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(mockAccPort), "port", strconv.Itoa(mockAccPort)),
+				resource.TestCheckResourceAttr(resourcePrefix(mockAccPort), "destination_ranges.#", "1"),
+			),
+		},
+		{
+			Config: testAccOutboundPortConfig(mockAccPort, "203.0.113.0/24", "198.51.100.10/32"),
+			Check:  resource.TestCheckResourceAttr(resourcePrefix(mockAccPort), "destination_ranges.#", "2"),
+		},
+		{
+			Config: testAccOutboundPortConfig(mockAccPort, "198.51.100.10/32"),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourcePrefix(mockAccPort), "destination_ranges.#", "1"),
+				resource.TestCheckTypeSetElemAttr(resourcePrefix(mockAccPort), "destination_ranges.*", "198.51.100.10/32"),
+			),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckOutboundPortDestroy,
+		Steps:             outboundPortTest,
+	})
+}
+
+func testAccOutboundPortConfig(port int, destinationRanges ...string) string {
+	return fmt.Sprintf("resource \"scp_outbound_ports\" \"port-%[1]d\" {\nport = %[1]d \ndestination_ranges = [\"%[2]s\"] \n}", port, strings.Join(destinationRanges, "\", \""))
+}
+
+func testAccCheckOutboundPortDestroy(s *terraform.State) error {
+	providerNew := acctest.Provider
+	diags := providerNew.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags != nil {
+		return fmt.Errorf("%+v", diags)
+	}
+	acsClient := *providerNew.Meta().(client.ACSProvider).Client
+	stack := providerNew.Meta().(client.ACSProvider).Stack
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != outboundports.ResourceKey {
+			continue
+		}
+
+		port, err := outboundports.ParsePortID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := acsClient.DescribeOutboundports(context.TODO(), stack, port)
+		if err != nil {
+			return fmt.Errorf("Unexpected Error %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return fmt.Errorf("Outbound port %d still exists", port)
+		} else if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("expected %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+func Test_ParsePortID(t *testing.T) {
+	t.Run("with valid port", func(t *testing.T) {
+		port, err := outboundports.ParsePortID("8089")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(8089), port)
+	})
+
+	t.Run("with invalid port", func(t *testing.T) {
+		for _, id := range []string{"", "abc", "0", "65536", "-1"} {
+			_, err := outboundports.ParsePortID(id)
+			assert.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package outboundports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// OutboundPortStatusCreate returns StateRefreshFunc that makes POST request and checks if response is accepted
+func OutboundPortStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		createBody := v2.AddOutboundportsJSONRequestBody{
+			OutboundPorts: &[]struct {
+				Port    *int32    `json:"port,omitempty"`
+				Subnets *[]string `json:"subnets,omitempty"`
+			}{
+				{
+					Port:    &port,
+					Subnets: &destinationRanges,
+				},
+			},
+		}
+		resp, err := acsClient.AddOutboundports(ctx, stack, createBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// OutboundPortStatusPoll returns StateRefreshFunc that makes GET request and checks if response is desired target (200 for create and 404 for delete)
+func OutboundPortStatusPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, targetStatus []string, pendingStatus []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundports(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, targetStatus, pendingStatus)
+	}
+}
+
+// OutboundPortStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns outbound port response
+func OutboundPortStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundports(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var outboundPorts []v2.OutboundResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return MergeOutboundResponses(port, outboundPorts), status, nil
+	}
+}
+
+// OutboundPortStatusDelete returns StateRefreshFunc that makes DELETE request for the given destination ranges and checks if request was accepted
+func OutboundPortStatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		deleteBody := v2.DeleteOutboundportJSONRequestBody{
+			Subnets: &destinationRanges,
+		}
+		resp, err := acsClient.DeleteOutboundport(ctx, stack, port, deleteBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// MergeOutboundResponses combines the outbound rules ACS returns for a port into a single response holding every destination range
+func MergeOutboundResponses(port int32, outboundPorts []v2.OutboundResponse) *v2.OutboundResponse {
+	destinationRanges := make([]string, 0)
+	for _, outboundPort := range outboundPorts {
+		if outboundPort.Port != nil && *outboundPort.Port != port {
+			continue
+		}
+		if outboundPort.DestinationRanges != nil {
+			destinationRanges = append(destinationRanges, *outboundPort.DestinationRanges...)
+		}
+	}
+	return &v2.OutboundResponse{
+		Port:              &port,
+		DestinationRanges: &destinationRanges,
+	}
+}
@@ -0,0 +1,117 @@
+package outboundports_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var acceptedResp = &http.Response{
+	StatusCode: http.StatusAccepted,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var successRespOk = &http.Response{
+	StatusCode: http.StatusOK,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var notFoundResp = &http.Response{
+	StatusCode: http.StatusNotFound,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var rateLimitResp = &http.Response{
+	StatusCode: http.StatusTooManyRequests,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+func Test_OutboundPortStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusOK), nil).Once()
+		output, statusText, err := outboundports.OutboundPortStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPort)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		outboundPort := output.(*v2.OutboundResponse)
+		assert.ElementsMatch(t, mockDestinationRanges, *outboundPort.DestinationRanges)
+		assert.Equal(t, mockPort, *outboundPort.Port)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusTooManyRequests), nil).Once()
+		_, statusText, err := outboundports.OutboundPortStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPort)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(http.StatusNotFound), nil).Once()
+		output, statusText, err := outboundports.OutboundPortStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPort)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		output, _, err := outboundports.OutboundPortStatusRead(context.TODO(), client, v2.Stack(mockStack), mockPort)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_MergeOutboundResponses(t *testing.T) {
+	otherPort := mockPort + 1
+	firstRanges := mockDestinationRanges[:1]
+	secondRanges := mockDestinationRanges[1:]
+	otherRanges := []string{"2.2.2.2/32"}
+
+	merged := outboundports.MergeOutboundResponses(mockPort, []v2.OutboundResponse{
+		{Port: &mockPort, DestinationRanges: &firstRanges},
+		{Port: &mockPort, DestinationRanges: &secondRanges},
+		{Port: &otherPort, DestinationRanges: &otherRanges},
+		{Port: &mockPort},
+	})
+	assert.Equal(t, mockPort, *merged.Port)
+	assert.ElementsMatch(t, mockDestinationRanges, *merged.DestinationRanges)
+}
+
+func genOutboundPortResp(code int) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		outboundPorts := []v2.OutboundResponse{
+			{
+				DestinationRanges: &mockDestinationRanges,
+				Name:              &mockName,
+				Port:              &mockPort,
+			},
+		}
+
+		b, _ = json.Marshal(&outboundPorts)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
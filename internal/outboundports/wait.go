@@ -0,0 +1,70 @@
+package outboundports
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// WaitOutboundPortCreate Handles retry logic for POST requests for create lifecycle function
+func WaitOutboundPortCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) error {
+	waitOutboundPortCreateAccepted := wait.GenerateWriteStateChangeConf(OutboundPortStatusCreate(ctx, acsClient, stack, port, destinationRanges))
+
+	rawResp, err := waitOutboundPortCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for outbound port (%d) to be created: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Create response status code for outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitOutboundPortPoll Handles retry logic for polling after POST and DELETE requests for create/delete lifecycle functions
+func WaitOutboundPortPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, targetStatus []string, pendingStatus []string) error {
+	waitOutboundPortState := wait.GenerateReadStateChangeConf(pendingStatus, targetStatus, OutboundPortStatusPoll(ctx, acsClient, stack, port, targetStatus, pendingStatus))
+
+	_, err := waitOutboundPortState.WaitForStateContext(ctx)
+	return err
+}
+
+// WaitOutboundPortRead Handles retry logic for GET requests for the read lifecycle function
+func WaitOutboundPortRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) (*v2.OutboundResponse, error) {
+	waitOutboundPortRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, OutboundPortStatusRead(ctx, acsClient, stack, port))
+
+	output, err := waitOutboundPortRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading outbound port (%d): %s", port, err))
+		return nil, err
+	}
+	outboundPort := output.(*v2.OutboundResponse)
+
+	return outboundPort, nil
+}
+
+// WaitOutboundPortDelete Handles retry logic for DELETE requests removing destination ranges from an outbound port
+func WaitOutboundPortDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) error {
+	waitOutboundPortDeleteAccepted := wait.GenerateWriteStateChangeConf(OutboundPortStatusDelete(ctx, acsClient, stack, port, destinationRanges))
+
+	rawResp, err := waitOutboundPortDeleteAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting outbound port (%d): %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	//Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Delete response status code for outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+	return nil
+}
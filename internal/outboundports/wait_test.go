@@ -0,0 +1,165 @@
+package outboundports_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+)
+
+var (
+	mockPort              int32 = 8089
+	mockName                    = "mock-outbound-port"
+	mockDestinationRanges       = []string{"1.1.1.1/32", "1.1.1.2/32"}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitOutboundPortCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.AddOutboundportsJSONRequestBody) bool {
+			ports := *body.OutboundPorts
+			return len(ports) == 1 && *ports[0].Port == mockPort && assert.ElementsMatch(t, mockDestinationRanges, *ports[0].Subnets)
+		})).Return(acceptedResp, nil).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(rateLimitResp, nil).Once()
+		client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(acceptedResp, nil).Once()
+		err := outboundports.WaitOutboundPortCreate(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("AddOutboundports", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genOutboundPortResp(statusCode), nil).Once()
+				err := outboundports.WaitOutboundPortCreate(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortPoll(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		err := outboundports.WaitOutboundPortPoll(context.TODO(), client, v2.Stack(mockStack), mockPort, wait.TargetStatusResourceExists, wait.PendingStatusVerifyCreated)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(successRespOk, nil).Once()
+		err := outboundports.WaitOutboundPortPoll(context.TODO(), client, v2.Stack(mockStack), mockPort, wait.TargetStatusResourceExists, wait.PendingStatusVerifyCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with expected http response 404", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(notFoundResp, nil).Once()
+		err := outboundports.WaitOutboundPortPoll(context.TODO(), client, v2.Stack(mockStack), mockPort, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range []int{400, 401, 403, 409, 501, 500, 503} {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(statusCode), nil).Once()
+				err := outboundports.WaitOutboundPortPoll(context.TODO(), client, v2.Stack(mockStack), mockPort, wait.TargetStatusResourceExists, wait.PendingStatusVerifyCreated)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		outboundPort, err := outboundports.WaitOutboundPortRead(context.TODO(), client, v2.Stack(mockStack), mockPort)
+		assert.Error(t, err)
+		assert.Nil(t, outboundPort)
+	})
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(200), nil).Once()
+		outboundPort, err := outboundports.WaitOutboundPortRead(context.TODO(), client, v2.Stack(mockStack), mockPort)
+		assert.NoError(t, err)
+		assert.NotNil(t, outboundPort)
+		assert.ElementsMatch(t, mockDestinationRanges, *outboundPort.DestinationRanges)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundports", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortResp(statusCode), nil).Once()
+				outboundPort, err := outboundports.WaitOutboundPortRead(context.TODO(), client, v2.Stack(mockStack), mockPort)
+				assert.Error(t, err)
+				assert.Nil(t, outboundPort)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	mockDeleteBody := v2.DeleteOutboundportJSONRequestBody{
+		Subnets: &mockDestinationRanges,
+	}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(nil, errors.New("some error")).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(acceptedResp, nil).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(rateLimitResp, nil).Once()
+		client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(acceptedResp, nil).Once()
+		err := outboundports.WaitOutboundPortDelete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DeleteOutboundport", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(genOutboundPortResp(statusCode), nil).Once()
+				err := outboundports.WaitOutboundPortDelete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,248 @@
+package outboundportsv6
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	ResourceKey = "scp_outbound_ports_v6"
+
+	schemaKeyPort              = "port"
+	schemaKeyDestinationRanges = "destination_ranges"
+)
+
+func outboundPortV6ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPort: {
+			Type:             schema.TypeInt,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+			Description: "The outbound port to open. No two resources should have the same port. Can not be updated after creation, " +
+				"if changed in config file terraform will propose a replacement (delete old outbound port and recreate with new port).",
+		},
+		schemaKeyDestinationRanges: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: ipv6CIDRValidationFunc,
+			},
+			Description: "Destination ranges is a list of IPv6 subnets in CIDR notation the stack is allowed to reach on the corresponding port.",
+			MinItems:    1,
+		},
+	}
+}
+
+func ResourceOutboundPortV6() *schema.Resource {
+	return &schema.Resource{
+		Description: "IPv6 Outbound Port Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ConfigureOutboundPorts " +
+			"for more latest, detailed information on attribute requirements and the ACS Outbound Ports API.",
+
+		CreateContext: resourceOutboundPortV6Create,
+		ReadContext:   resourceOutboundPortV6Read,
+		UpdateContext: resourceOutboundPortV6Update,
+		DeleteContext: resourceOutboundPortV6Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: outboundPortV6ResourceSchema(),
+	}
+}
+
+func resourceOutboundPortV6Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Retrieve data for each field and create request body
+	port, _, newRangesSet := parseOutboundPortV6Request(d)
+	addRanges := utils.GetSubnetsFromSet(newRangesSet)
+
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitOutboundPortV6Create(ctx, acsClient, stack, port, addRanges)
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for IPv6 outbound port (%d) to be created: %s", port, err)
+	}
+
+	// Poll outbound port until it is visible with all requested destination ranges, other ranges of the port are left as they are
+	err = WaitVerifyOutboundPortV6Visible(ctx, acsClient, stack, port, addRanges, nil)
+	if err != nil {
+		return diag.Errorf("Error waiting for IPv6 outbound port (%d) to be created: %s", port, err)
+	}
+
+	// Set ID of outbound port resource to indicate port has been created
+	d.SetId(strconv.Itoa(int(port)))
+	tflog.Info(ctx, fmt.Sprintf("Created IPv6 outbound port resource: %d\n", port))
+
+	// Call read to set attributes of outbound port
+	return resourceOutboundPortV6Read(ctx, d, m)
+}
+
+func resourceOutboundPortV6Read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	port, err := outboundports.ParsePortID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outboundPort, err := WaitOutboundPortV6Read(ctx, acsClient, stack, port)
+	if err != nil {
+		// if outbound port not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing IPv6 outbound port from state. Not Found error while reading outbound port (%d): %s.", port, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading IPv6 outbound port (%d): %s", port, err)
+	}
+
+	if err := d.Set(schemaKeyPort, int(port)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Ranges changed outside of Terraform are surfaced as drift, while ranges ACS only reformatted keep the configured notation
+	destinationRanges := make([]string, 0)
+	if outboundPort.DestinationRanges != nil {
+		destinationRanges = MatchConfiguredRanges(*outboundPort.DestinationRanges, utils.ParseSetValues(d.Get(schemaKeyDestinationRanges)))
+	}
+	if err := d.Set(schemaKeyDestinationRanges, destinationRanges); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOutboundPortV6Update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Determine the changes to the destination ranges for a port
+	port, oldRangesSet, newRangesSet := parseOutboundPortV6Request(d)
+	addRanges := utils.GetSubnetsFromSet(newRangesSet.Difference(oldRangesSet))
+	deleteRanges := utils.GetSubnetsFromSet(oldRangesSet.Difference(newRangesSet))
+
+	// Add new ranges first so the port is never left without a destination range
+	if len(addRanges) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitOutboundPortV6Create(ctx, acsClient, stack, port, addRanges)
+		}); err != nil {
+			return diag.Errorf("Error updating IPv6 outbound port (%d): %s", port, err)
+		}
+	}
+
+	if len(deleteRanges) > 0 {
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitOutboundPortV6Delete(ctx, acsClient, stack, port, deleteRanges)
+		}); err != nil {
+			return diag.Errorf("Error updating IPv6 outbound port (%d): %s", port, err)
+		}
+	}
+
+	// Poll until the outbound port reports the configured destination ranges and none of the removed ranges
+	if err := WaitVerifyOutboundPortV6Visible(ctx, acsClient, stack, port, utils.GetSubnetsFromSet(newRangesSet), deleteRanges); err != nil {
+		return diag.Errorf("Error waiting for IPv6 outbound port (%d) to be updated: %s", port, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated IPv6 outbound port resource: %d\n", port))
+
+	return resourceOutboundPortV6Read(ctx, d, m)
+}
+
+func resourceOutboundPortV6Delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	port, oldRangesSet, _ := parseOutboundPortV6Request(d)
+	deleteRanges := utils.GetSubnetsFromSet(oldRangesSet)
+
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitOutboundPortV6Delete(ctx, acsClient, stack, port, deleteRanges)
+	})
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("IPv6 outbound port (%d) already removed: %s.", port, err))
+			return nil
+		}
+		return diag.Errorf("Error deleting IPv6 outbound port (%d): %s", port, err)
+	}
+
+	//Poll outbound port until GET returns 404 Not found - port has been deleted
+	err = WaitOutboundPortV6Poll(ctx, acsClient, stack, port, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+	if err != nil {
+		return diag.Errorf("Error waiting for IPv6 outbound port (%d) to be deleted: %s", port, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted IPv6 outbound port resource: %d\n", port))
+	return nil
+}
+
+func parseOutboundPortV6Request(d *schema.ResourceData) (port int32, oldRanges *schema.Set, newRanges *schema.Set) {
+	port = int32(d.Get(schemaKeyPort).(int))
+
+	rawOriginalRanges, rawNewRanges := d.GetChange(schemaKeyDestinationRanges)
+	oldRanges = rawOriginalRanges.(*schema.Set)
+	newRanges = rawNewRanges.(*schema.Set)
+	return port, oldRanges, newRanges
+}
+
+// MatchConfiguredRanges returns the ranges reported by ACS, substituting the configured notation for any range that only
+// differs from the configuration in formatting
+func MatchConfiguredRanges(actualRanges []string, configuredRanges []string) []string {
+	configuredByCanonical := make(map[string]string, len(configuredRanges))
+	for _, configuredRange := range configuredRanges {
+		configuredByCanonical[CanonicalIPv6CIDR(configuredRange)] = configuredRange
+	}
+
+	result := make([]string, 0, len(actualRanges))
+	for _, actualRange := range actualRanges {
+		if configuredRange, ok := configuredByCanonical[CanonicalIPv6CIDR(actualRange)]; ok {
+			result = append(result, configuredRange)
+			continue
+		}
+		result = append(result, actualRange)
+	}
+	return result
+}
+
+func ipv6CIDRValidationFunc(v interface{}, _ cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	currentValue := v.(string)
+
+	ip, _, err := net.ParseCIDR(currentValue)
+	if err != nil || ip.To4() != nil {
+		errorDiag := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "invalid value",
+			Detail:   fmt.Sprintf("%s must contain IPv6 ranges in CIDR notation (e.g. 2001:db8::/32), got: %s", schemaKeyDestinationRanges, currentValue),
+		}
+		diags = append(diags, errorDiag)
+	}
+	return diags
+}
@@ -0,0 +1,40 @@
+package outboundportsv6_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/internal/outboundportsv6"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DestinationRangesValidation(t *testing.T) {
+	validate := outboundportsv6.ResourceOutboundPortV6().Schema["destination_ranges"].Elem.(*schema.Schema).ValidateDiagFunc
+
+	t.Run("with valid IPv6 ranges", func(t *testing.T) {
+		for _, value := range []string{"2001:db8::/32", "::/0", "fd00::1/128"} {
+			assert.False(t, validate(value, cty.Path{}).HasError(), value)
+		}
+	})
+
+	t.Run("with invalid ranges", func(t *testing.T) {
+		for _, value := range []string{"", "2001:db8::", "1.1.1.1/32", "2001:db8::/129", "not-a-cidr"} {
+			assert.True(t, validate(value, cty.Path{}).HasError(), value)
+		}
+	})
+}
+
+func Test_MatchConfiguredRanges(t *testing.T) {
+	configured := []string{"2001:0db8::/32", "fd00::1/128"}
+
+	t.Run("keeps configured notation for reformatted ranges", func(t *testing.T) {
+		got := outboundportsv6.MatchConfiguredRanges([]string{"2001:db8::/32", "fd00::1/128"}, configured)
+		assert.ElementsMatch(t, configured, got)
+	})
+
+	t.Run("reports ranges changed outside of terraform", func(t *testing.T) {
+		got := outboundportsv6.MatchConfiguredRanges([]string{"2001:db8::/32", "fd00::2/128"}, configured)
+		assert.ElementsMatch(t, []string{"2001:0db8::/32", "fd00::2/128"}, got)
+	})
+}
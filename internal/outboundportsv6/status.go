@@ -0,0 +1,166 @@
+package outboundportsv6
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// OutboundPortV6StatusCreate returns StateRefreshFunc that makes POST request and checks if response is accepted
+func OutboundPortV6StatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		createBody := v2.CreateOutboundPortsV6JSONRequestBody{
+			OutboundPorts: &[]struct {
+				Port    *int32    `json:"port,omitempty"`
+				Subnets *[]string `json:"subnets,omitempty"`
+			}{
+				{
+					Port:    &port,
+					Subnets: &destinationRanges,
+				},
+			},
+		}
+		resp, err := acsClient.CreateOutboundPortsV6(ctx, stack, createBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// OutboundPortV6StatusPoll returns StateRefreshFunc that makes GET request and checks if response is desired target (404 for delete)
+func OutboundPortV6StatusPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, targetStatus []string, pendingStatus []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundportsV6(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, targetStatus, pendingStatus)
+	}
+}
+
+// OutboundPortV6StatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns outbound port response
+func OutboundPortV6StatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundportsV6(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var outboundPorts []v2.OutboundResponse
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return outboundports.MergeOutboundResponses(port, outboundPorts), status, nil
+	}
+}
+
+// OutboundPortV6StatusVerifyVisible returns a StateRefreshFunc that makes a GET request and checks to see if the outbound
+// port has every expected destination range and none of the removed ranges. Other ranges of the port, e.g. added outside
+// of Terraform, are ignored. A 404 is treated as pending since the rule may not be visible yet.
+func OutboundPortV6StatusVerifyVisible(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string, removedRanges []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeOutboundportsV6(ctx, stack, port)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{LastError: errors.New(string(bodyBytes))}
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			var outboundPorts []v2.OutboundResponse
+			if err = json.Unmarshal(bodyBytes, &outboundPorts); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			outboundPort := outboundports.MergeOutboundResponses(port, outboundPorts)
+			if VerifyOutboundPortV6Ranges(destinationRanges, removedRanges, *outboundPort) {
+				return outboundPort, status.UpdatedStatus, nil
+			}
+		}
+
+		return nil, http.StatusText(resp.StatusCode), nil
+	}
+}
+
+// OutboundPortV6StatusDelete returns StateRefreshFunc that makes DELETE request for the given destination ranges and checks if request was accepted
+func OutboundPortV6StatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		deleteBody := v2.DeleteOutboundPortV6JSONRequestBody{
+			Subnets: &destinationRanges,
+		}
+		resp, err := acsClient.DeleteOutboundPortV6(ctx, stack, port, deleteBody)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// VerifyOutboundPortV6Ranges is a helper to verify that the outbound port response contains every expected destination
+// range and none of the removed ranges. Ranges are compared in their canonical form since ACS may return a compressed
+// IPv6 notation.
+func VerifyOutboundPortV6Ranges(destinationRanges []string, removedRanges []string, outboundPort v2.OutboundResponse) bool {
+	actual := make(map[string]bool)
+	if outboundPort.DestinationRanges != nil {
+		for _, destinationRange := range *outboundPort.DestinationRanges {
+			actual[CanonicalIPv6CIDR(destinationRange)] = true
+		}
+	}
+	for _, destinationRange := range destinationRanges {
+		if !actual[CanonicalIPv6CIDR(destinationRange)] {
+			return false
+		}
+	}
+	for _, removedRange := range removedRanges {
+		if actual[CanonicalIPv6CIDR(removedRange)] {
+			return false
+		}
+	}
+	return true
+}
+
+// CanonicalIPv6CIDR returns the canonical notation of an IPv6 CIDR, or the value unchanged if it can not be parsed
+func CanonicalIPv6CIDR(value string) string {
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return value
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip.String(), prefixLength)
+}
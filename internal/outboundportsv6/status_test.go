@@ -0,0 +1,126 @@
+package outboundportsv6_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundportsv6"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var acceptedResp = &http.Response{
+	StatusCode: http.StatusAccepted,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var notFoundResp = &http.Response{
+	StatusCode: http.StatusNotFound,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var rateLimitResp = &http.Response{
+	StatusCode: http.StatusTooManyRequests,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+func Test_VerifyOutboundPortV6Ranges(t *testing.T) {
+	t.Run("with matching ranges in different order", func(t *testing.T) {
+		ranges := []string{mockDestinationRanges[1], mockDestinationRanges[0]}
+		assert.True(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, nil, v2.OutboundResponse{DestinationRanges: &ranges}))
+	})
+
+	t.Run("with ranges in a different notation", func(t *testing.T) {
+		ranges := []string{"2001:0db8:0000:0000:0000:0000:0000:0000/32", "2001:0db8:0000:0000:0000:0000:0000:0001/128"}
+		assert.True(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, nil, v2.OutboundResponse{DestinationRanges: &ranges}))
+	})
+
+	t.Run("with missing range", func(t *testing.T) {
+		ranges := []string{mockDestinationRanges[0]}
+		assert.False(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, nil, v2.OutboundResponse{DestinationRanges: &ranges}))
+	})
+
+	t.Run("with additional ranges on the port", func(t *testing.T) {
+		ranges := append([]string{"2001:db8:ffff::/48"}, mockDestinationRanges...)
+		assert.True(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, nil, v2.OutboundResponse{DestinationRanges: &ranges}))
+	})
+
+	t.Run("with removed range still present", func(t *testing.T) {
+		ranges := append([]string{"2001:db8:ffff::/48"}, mockDestinationRanges...)
+		assert.False(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, []string{"2001:0db8:ffff::/48"}, v2.OutboundResponse{DestinationRanges: &ranges}))
+		assert.True(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, []string{"2001:db8:eeee::/48"}, v2.OutboundResponse{DestinationRanges: &ranges}))
+	})
+
+	t.Run("with nil ranges", func(t *testing.T) {
+		assert.False(t, outboundportsv6.VerifyOutboundPortV6Ranges(mockDestinationRanges, nil, v2.OutboundResponse{}))
+	})
+}
+
+func Test_OutboundPortV6StatusVerifyVisible(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with matching ranges", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(http.StatusOK, mockDestinationRanges), nil).Once()
+		output, statusText, err := outboundportsv6.OutboundPortV6StatusVerifyVisible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)()
+		assert.NoError(t, err)
+		assert.NotNil(t, output)
+		assert.Equal(t, status.UpdatedStatus, statusText)
+	})
+
+	t.Run("with ranges not yet visible", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(http.StatusOK, mockDestinationRanges[:1]), nil).Once()
+		output, statusText, err := outboundportsv6.OutboundPortV6StatusVerifyVisible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with port not yet visible", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(http.StatusNotFound, nil), nil).Once()
+		output, statusText, err := outboundportsv6.OutboundPortV6StatusVerifyVisible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(http.StatusBadRequest, nil), nil).Once()
+		_, _, err := outboundportsv6.OutboundPortV6StatusVerifyVisible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)()
+		assert.Error(t, err)
+	})
+}
+
+func genOutboundPortV6Resp(code int, destinationRanges []string) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		outboundPorts := []v2.OutboundResponse{
+			{
+				DestinationRanges: &destinationRanges,
+				Name:              &mockName,
+				Port:              &mockPort,
+			},
+		}
+
+		b, _ = json.Marshal(&outboundPorts)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,89 @@
+package outboundportsv6
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	PendingStatusVerifyVisible = []string{http.StatusText(http.StatusOK), http.StatusText(http.StatusNotFound), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitOutboundPortV6Create Handles retry logic for POST requests for create lifecycle function
+func WaitOutboundPortV6Create(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) error {
+	waitOutboundPortCreateAccepted := wait.GenerateWriteStateChangeConf(OutboundPortV6StatusCreate(ctx, acsClient, stack, port, destinationRanges))
+
+	rawResp, err := waitOutboundPortCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for IPv6 outbound port (%d) to be created: %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Create response status code for IPv6 outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for IPv6 outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitOutboundPortV6Poll Handles retry logic for polling after DELETE requests for the delete lifecycle function
+func WaitOutboundPortV6Poll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, targetStatus []string, pendingStatus []string) error {
+	waitOutboundPortState := wait.GenerateReadStateChangeConf(pendingStatus, targetStatus, OutboundPortV6StatusPoll(ctx, acsClient, stack, port, targetStatus, pendingStatus))
+
+	_, err := waitOutboundPortState.WaitForStateContext(ctx)
+	return err
+}
+
+// WaitVerifyOutboundPortV6Visible Handles retry logic for GET requests after create and update to verify that the outbound
+// port is visible with the expected destination ranges and without the removed ranges
+func WaitVerifyOutboundPortV6Visible(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string, removedRanges []string) error {
+	waitOutboundPortVisible := wait.GenerateReadStateChangeConf(PendingStatusVerifyVisible, []string{status.UpdatedStatus}, OutboundPortV6StatusVerifyVisible(ctx, acsClient, stack, port, destinationRanges, removedRanges))
+
+	_, err := waitOutboundPortVisible.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error confirming IPv6 outbound port (%d) is visible: %s", port, err))
+		return err
+	}
+
+	return nil
+}
+
+// WaitOutboundPortV6Read Handles retry logic for GET requests for the read lifecycle function
+func WaitOutboundPortV6Read(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32) (*v2.OutboundResponse, error) {
+	waitOutboundPortRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, OutboundPortV6StatusRead(ctx, acsClient, stack, port))
+
+	output, err := waitOutboundPortRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading IPv6 outbound port (%d): %s", port, err))
+		return nil, err
+	}
+	outboundPort := output.(*v2.OutboundResponse)
+
+	return outboundPort, nil
+}
+
+// WaitOutboundPortV6Delete Handles retry logic for DELETE requests removing destination ranges from an outbound port
+func WaitOutboundPortV6Delete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, port int32, destinationRanges []string) error {
+	waitOutboundPortDeleteAccepted := wait.GenerateWriteStateChangeConf(OutboundPortV6StatusDelete(ctx, acsClient, stack, port, destinationRanges))
+
+	rawResp, err := waitOutboundPortDeleteAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting IPv6 outbound port (%d): %s", port, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	//Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Delete response status code for IPv6 outbound port (%d): %d\n", port, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for IPv6 outbound port (%d): %s\n", port, resp.Header.Get("X-REQUEST-ID")))
+	return nil
+}
@@ -0,0 +1,152 @@
+package outboundportsv6_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/outboundportsv6"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+)
+
+var (
+	mockPort              int32 = 8089
+	mockName                    = "mock-outbound-port-v6"
+	mockDestinationRanges       = []string{"2001:db8::/32", "2001:db8::1/128"}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitOutboundPortV6Create(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := outboundportsv6.WaitOutboundPortV6Create(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.CreateOutboundPortsV6JSONRequestBody) bool {
+			ports := *body.OutboundPorts
+			return len(ports) == 1 && *ports[0].Port == mockPort && assert.ElementsMatch(t, mockDestinationRanges, *ports[0].Subnets)
+		})).Return(acceptedResp, nil).Once()
+		err := outboundportsv6.WaitOutboundPortV6Create(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(rateLimitResp, nil).Once()
+		client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(acceptedResp, nil).Once()
+		err := outboundportsv6.WaitOutboundPortV6Create(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("CreateOutboundPortsV6", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genOutboundPortV6Resp(statusCode, nil), nil).Once()
+				err := outboundportsv6.WaitOutboundPortV6Create(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitVerifyOutboundPortV6Visible(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(nil, errors.New("some error")).Once()
+		err := outboundportsv6.WaitVerifyOutboundPortV6Visible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("with port visible after not found", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(notFoundResp, nil).Once()
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(200, mockDestinationRanges), nil).Once()
+		err := outboundportsv6.WaitVerifyOutboundPortV6Visible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with ranges visible after partial response", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(200, mockDestinationRanges[:1]), nil).Once()
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(200, mockDestinationRanges), nil).Once()
+		err := outboundportsv6.WaitVerifyOutboundPortV6Visible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range []int{400, 401, 403, 409, 501, 500, 503} {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(statusCode, nil), nil).Once()
+				err := outboundportsv6.WaitVerifyOutboundPortV6Visible(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges, nil)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortV6Read(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(200, mockDestinationRanges), nil).Once()
+		outboundPort, err := outboundportsv6.WaitOutboundPortV6Read(context.TODO(), client, v2.Stack(mockStack), mockPort)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, mockDestinationRanges, *outboundPort.DestinationRanges)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected response %v", statusCode), func(t *testing.T) {
+				client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(genOutboundPortV6Resp(statusCode, nil), nil).Once()
+				outboundPort, err := outboundportsv6.WaitOutboundPortV6Read(context.TODO(), client, v2.Stack(mockStack), mockPort)
+				assert.Error(t, err)
+				assert.Nil(t, outboundPort)
+			})
+		}
+	})
+}
+
+func Test_WaitOutboundPortV6Delete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	mockDeleteBody := v2.DeleteOutboundPortV6JSONRequestBody{
+		Subnets: &mockDestinationRanges,
+	}
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(acceptedResp, nil).Once()
+		err := outboundportsv6.WaitOutboundPortV6Delete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DeleteOutboundPortV6", mock.Anything, v2.Stack(mockStack), mockPort, mockDeleteBody).Return(genOutboundPortV6Resp(statusCode, nil), nil).Once()
+				err := outboundportsv6.WaitOutboundPortV6Delete(context.TODO(), client, v2.Stack(mockStack), mockPort, mockDestinationRanges)
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("with poll until deleted", func(t *testing.T) {
+		client.On("DescribeOutboundportsV6", mock.Anything, v2.Stack(mockStack), mockPort).Return(notFoundResp, nil).Once()
+		err := outboundportsv6.WaitOutboundPortV6Poll(context.TODO(), client, v2.Stack(mockStack), mockPort, wait.TargetStatusResourceDeleted, wait.PendingStatusVerifyDeleted)
+		assert.NoError(t, err)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/locks"
 	"github.com/splunk/terraform-provider-scp/internal/wait"
@@ -108,6 +109,13 @@ func resourcePrivateAppCreate(ctx context.Context, resourceData *schema.Resource
 			if errors.IsConflictError(err.Err) {
 				return resource.NonRetryableError(err.Err)
 			}
+			if errors.IsFailedDeploymentTaskError(err.Err) {
+				// retry the failed deployment task, the create request is resubmitted by the next attempt
+				if retryErr := deployments.WaitRetryTask(ctx, acsClient, stack); retryErr != nil {
+					return resource.NonRetryableError(retryErr)
+				}
+				return resource.RetryableError(err.Err)
+			}
 			if strings.Contains(err.Err.Error(), "503") {
 				return resource.RetryableError(fmt.Errorf("received 503 error, retrying: %v", err.Err))
 			}
@@ -186,7 +194,9 @@ func resourcePrivateAppDelete(ctx context.Context, resourceData *schema.Resource
 	appName := resourceData.Id()
 
 	err := resource.RetryContext(ctx, 2*RetryTimeout, func() *resource.RetryError {
-		err := WaitAppDelete(ctx, acsClient, stack, appName)
+		err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitAppDelete(ctx, acsClient, stack, appName)
+		})
 		if err != nil {
 			if strings.Contains(err.Error(), "503") {
 				return resource.RetryableError(fmt.Errorf("received 503 error, retrying: %w", err))
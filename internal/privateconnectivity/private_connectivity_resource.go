@@ -0,0 +1,314 @@
+package privateconnectivity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+)
+
+const (
+	ResourceKey = "scp_private_connectivity"
+
+	FeatureSearch = "search"
+	FeatureIngest = "ingest"
+
+	schemaKeyFeatures           = "features"
+	schemaKeyCustomerAccountIDs = "customer_account_ids"
+	schemaKeyEndpoints          = "endpoints"
+
+	schemaKeyFeature                 = "feature"
+	schemaKeyEndpoint                = "endpoint"
+	schemaKeyEndpointV6              = "endpoint_v6"
+	schemaKeyStatus                  = "status"
+	schemaKeyResourceID              = "resource_id"
+	schemaKeyTargetSubResource       = "target_sub_resource"
+	schemaKeyPrivateSearchDNSRecords = "private_search_dns_records"
+)
+
+func privateConnectivityResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyFeatures: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{FeatureSearch, FeatureIngest}, false)),
+			},
+			Description: "Set of features to enable private connectivity for. Supported features are `search` and `ingest`.",
+		},
+		schemaKeyCustomerAccountIDs: {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			Description: "Set of cloud provider account IDs allowed to connect to the private endpoints.",
+		},
+		schemaKeyEndpoints: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaKeyFeature: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The feature of the endpoint.",
+					},
+					schemaKeyEndpoint: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The service name of the private endpoint, use it as the service name of the VPC endpoint.",
+					},
+					schemaKeyEndpointV6: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The IPv6 service name of the private endpoint.",
+					},
+					schemaKeyStatus: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the endpoint.",
+					},
+					schemaKeyCustomerAccountIDs: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The cloud provider account IDs allowed to connect to the endpoint.",
+					},
+					schemaKeyResourceID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The resource ID of the endpoint.",
+					},
+					schemaKeyTargetSubResource: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The target sub resource of the endpoint.",
+					},
+					schemaKeyPrivateSearchDNSRecords: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The DNS records to create for private search.",
+					},
+				},
+			},
+			Description: "The private endpoints of the stack.",
+		},
+	}
+}
+
+func ResourcePrivateConnectivity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Private Connectivity Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Admin/PrivateConnectivityEnable " +
+			"for more latest, detailed information on attribute requirements and the ACS Private Connectivity API.",
+
+		CreateContext: resourcePrivateConnectivityCreate,
+		ReadContext:   resourcePrivateConnectivityRead,
+		UpdateContext: resourcePrivateConnectivityUpdate,
+		DeleteContext: resourcePrivateConnectivityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: privateConnectivityResourceSchema(),
+	}
+}
+
+func resourcePrivateConnectivityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	features := utils.ParseSetValues(d.Get(schemaKeyFeatures))
+	customerAccountIDs := utils.ParseSetValues(d.Get(schemaKeyCustomerAccountIDs))
+
+	// Check eligibility first so that an ineligible stack fails with the reason returned by ACS
+	eligibility, err := WaitEligibilityRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error checking private connectivity eligibility: %s", err)
+	}
+	if eligibility.Eligible == nil || !*eligibility.Eligible {
+		reason := "no reason returned"
+		if eligibility.Reason != nil {
+			reason = *eligibility.Reason
+		}
+		return diag.Errorf("Stack (%s) is not eligible for private connectivity: %s", stack, reason)
+	}
+
+	privateConnectivityFeatures := v2.PrivateConnectivityFeatures(features)
+	enableRequest := v2.EnablePrivateConnectivityJSONRequestBody{
+		CustomerAccountIds: &customerAccountIDs,
+		Feature:            &privateConnectivityFeatures,
+	}
+	if err = deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitPrivateConnectivityEnable(ctx, acsClient, stack, enableRequest)
+	}); err != nil {
+		return diag.Errorf("Error submitting request for private connectivity to be enabled: %s", err)
+	}
+
+	if err = WaitEndpointsReady(ctx, acsClient, stack, features, customerAccountIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for private connectivity endpoints to be ready: %s", err)
+	}
+
+	// Private connectivity is a single configuration per stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created private connectivity resource: %s\n", stack))
+
+	// Call read to set attributes of private connectivity
+	return resourcePrivateConnectivityRead(ctx, d, m)
+}
+
+func resourcePrivateConnectivityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	endpoints, err := WaitPrivateConnectivityRead(ctx, acsClient, stack)
+	if err != nil {
+		// if private connectivity not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing private connectivity from state. Not Found error while reading private connectivity: %s.", err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading private connectivity: %s", err)
+	}
+	if len(endpoints) == 0 {
+		tflog.Info(ctx, "Removing private connectivity from state. No private connectivity endpoints returned.")
+		d.SetId("")
+		return nil
+	}
+
+	// endpoints of features that are not configured are managed outside of this resource, e.g. enabled through a support
+	// request, and are ignored unless no feature is configured yet as on import
+	if configuredFeatures := utils.ParseSetValues(d.Get(schemaKeyFeatures)); len(configuredFeatures) > 0 {
+		endpoints = filterEndpoints(endpoints, configuredFeatures)
+	}
+
+	features, customerAccountIDs, flattenedEndpoints := flattenEndpoints(endpoints)
+
+	if err := d.Set(schemaKeyFeatures, features); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyCustomerAccountIDs, customerAccountIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyEndpoints, flattenedEndpoints); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePrivateConnectivityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChanges(schemaKeyFeatures, schemaKeyCustomerAccountIDs) {
+		features := utils.ParseSetValues(d.Get(schemaKeyFeatures))
+		customerAccountIDs := utils.ParseSetValues(d.Get(schemaKeyCustomerAccountIDs))
+
+		privateConnectivityFeatures := v2.PrivateConnectivityFeatures(features)
+		updateRequest := v2.UpdatePrivateConnectivityJSONRequestBody{
+			CustomerAccountIds: &customerAccountIDs,
+			Feature:            &privateConnectivityFeatures,
+		}
+		if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitPrivateConnectivityUpdate(ctx, acsClient, stack, updateRequest)
+		}); err != nil {
+			return diag.Errorf("Error submitting request for private connectivity to be updated: %s", err)
+		}
+
+		if err := WaitEndpointsReady(ctx, acsClient, stack, features, customerAccountIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error waiting for private connectivity endpoints to be ready: %s", err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated private connectivity resource: %s\n", d.Id()))
+	}
+
+	return resourcePrivateConnectivityRead(ctx, d, m)
+}
+
+func resourcePrivateConnectivityDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support disabling private connectivity, the resource is only removed from terraform state
+	tflog.Warn(ctx, fmt.Sprintf("Private connectivity (%s) removed from state only. ACS does not support disabling private connectivity, "+
+		"please contact Splunk support to remove the private endpoints.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// filterEndpoints returns the endpoints of the features
+func filterEndpoints(endpoints []v2.PrivateConnectivityEndpoints, features []string) []v2.PrivateConnectivityEndpoints {
+	filtered := make([]v2.PrivateConnectivityEndpoints, 0, len(endpoints))
+	for _, feature := range features {
+		if endpoint := FindEndpoint(endpoints, feature); endpoint != nil {
+			filtered = append(filtered, *endpoint)
+		}
+	}
+	return filtered
+}
+
+// flattenEndpoints returns the features and the customer account IDs of all endpoints along with the flattened endpoints
+func flattenEndpoints(endpoints []v2.PrivateConnectivityEndpoints) ([]string, []string, []interface{}) {
+	features := make([]string, 0, len(endpoints))
+	accountIDSet := make(map[string]bool)
+	flattened := make([]interface{}, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		if endpoint.Feature != nil {
+			features = append(features, *endpoint.Feature)
+		}
+
+		var customerAccountIDs, dnsRecords []string
+		if endpoint.CustomerAccountIds != nil {
+			customerAccountIDs = *endpoint.CustomerAccountIds
+			for _, accountID := range customerAccountIDs {
+				accountIDSet[accountID] = true
+			}
+		}
+		if endpoint.PrivateSearchDNSRecords != nil {
+			dnsRecords = *endpoint.PrivateSearchDNSRecords
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			schemaKeyFeature:                 endpoint.Feature,
+			schemaKeyEndpoint:                endpoint.Endpoint,
+			schemaKeyEndpointV6:              endpoint.EndpointV6,
+			schemaKeyStatus:                  endpoint.Status,
+			schemaKeyCustomerAccountIDs:      customerAccountIDs,
+			schemaKeyResourceID:              endpoint.ResourceId,
+			schemaKeyTargetSubResource:       endpoint.TargetSubResource,
+			schemaKeyPrivateSearchDNSRecords: dnsRecords,
+		})
+	}
+
+	customerAccountIDs := make([]string, 0, len(accountIDSet))
+	for accountID := range accountIDSet {
+		customerAccountIDs = append(customerAccountIDs, accountID)
+	}
+	sort.Strings(customerAccountIDs)
+
+	return features, customerAccountIDs, flattened
+}
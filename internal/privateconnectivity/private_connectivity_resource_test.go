@@ -0,0 +1,102 @@
+package privateconnectivity_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR PRIVATE CONNECTIVITY RESOURCE
+
+Private connectivity can only be enabled on eligible stacks and ACS does not support disabling it again, so an acceptance
+test would leave private endpoints on the acceptance test stack that only Splunk support can remove. The create, read
+and update flows are covered against a mocked client below.
+*/
+
+func Test_ResourcePrivateConnectivityRead(t *testing.T) {
+	t.Run("with endpoint of feature not configured", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+
+		d := genResourceData(t, []interface{}{privateconnectivity.FeatureSearch}, mockAccountIDs)
+		diags := privateconnectivity.ResourcePrivateConnectivity().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, []interface{}{privateconnectivity.FeatureSearch}, d.Get("features").(*schema.Set).List())
+		assert.Equal(t, 1, d.Get("endpoints.#"))
+		assert.Equal(t, privateconnectivity.FeatureSearch, d.Get("endpoints.0.feature"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with no feature configured on import", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+
+		d := genResourceData(t, []interface{}{}, []string{})
+		diags := privateconnectivity.ResourcePrivateConnectivity().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, 2, d.Get("features").(*schema.Set).Len())
+		assert.Equal(t, 2, d.Get("customer_account_ids").(*schema.Set).Len())
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with private connectivity not found", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusNotFound, nil), nil).Once()
+
+		d := genResourceData(t, []interface{}{privateconnectivity.FeatureSearch}, mockAccountIDs)
+		d.SetId(mockStack)
+		diags := privateconnectivity.ResourcePrivateConnectivity().ReadContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Empty(t, d.Id())
+	})
+}
+
+func Test_ResourcePrivateConnectivityUpdate(t *testing.T) {
+	t.Run("with account removed waits until account is no longer allowed", func(t *testing.T) {
+		remainingAccountIDs := []string{mockAccountIDs[0]}
+		updatedEndpoints := genEndpoints(privateconnectivity.EndpointStatusReady)
+		for i := range updatedEndpoints {
+			updatedEndpoints[i].CustomerAccountIds = &remainingAccountIDs
+		}
+
+		client := &mocks.ClientInterface{}
+		client.On("UpdatePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.UpdatePrivateConnectivityJSONRequestBody) bool {
+			return body.CustomerAccountIds != nil && len(*body.CustomerAccountIds) == 1 && (*body.CustomerAccountIds)[0] == mockAccountIDs[0]
+		})).Return(genEndpointsResp(http.StatusAccepted, nil), nil).Once()
+		// the removed account is still allowed on the first poll
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, updatedEndpoints), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, updatedEndpoints), nil).Once()
+
+		d := genResourceData(t, []interface{}{privateconnectivity.FeatureSearch, privateconnectivity.FeatureIngest}, remainingAccountIDs)
+		d.SetId(mockStack)
+		diags := privateconnectivity.ResourcePrivateConnectivity().UpdateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, []interface{}{mockAccountIDs[0]}, d.Get("customer_account_ids").(*schema.Set).List())
+		client.AssertExpectations(t)
+	})
+}
+
+func genResourceData(t *testing.T, features []interface{}, customerAccountIDs []string) *schema.ResourceData {
+	accountIDs := make([]interface{}, 0, len(customerAccountIDs))
+	for _, accountID := range customerAccountIDs {
+		accountIDs = append(accountIDs, accountID)
+	}
+	return schema.TestResourceDataRaw(t, privateconnectivity.ResourcePrivateConnectivity().Schema, map[string]interface{}{
+		"features":             features,
+		"customer_account_ids": accountIDs,
+	})
+}
+
+func genACSProvider(acsClient v2.ClientInterface) client.ACSProvider {
+	return client.ACSProvider{Client: &acsClient, Stack: v2.Stack(mockStack)}
+}
@@ -0,0 +1,209 @@
+package privateconnectivity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/utils"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	EndpointStatusReady  = "Ready"
+	EndpointStatusFailed = "Failed"
+
+	// EndpointsPendingStatus is returned while an endpoint of a requested feature is not ready yet
+	EndpointsPendingStatus = "ENDPOINTS_PENDING"
+	EndpointsReadyStatus   = "ENDPOINTS_READY"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// EligibilityStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the eligibility of the stack
+func EligibilityStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ValidatePrivateConnectivity(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var eligibility v2.DescribeEligibilityPrivateConnectivity
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &eligibility); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &eligibility, status, nil
+	}
+}
+
+// PrivateConnectivityStatusEnable returns StateRefreshFunc that makes POST request and checks if request was accepted
+func PrivateConnectivityStatusEnable(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, enableRequest v2.EnablePrivateConnectivityJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.EnablePrivateConnectivity(ctx, stack, enableRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// PrivateConnectivityStatusUpdate returns StateRefreshFunc that makes PATCH request and checks if request was accepted
+func PrivateConnectivityStatusUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdatePrivateConnectivityJSONRequestBody) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.UpdatePrivateConnectivity(ctx, stack, updateRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// PrivateConnectivityStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the endpoints
+func PrivateConnectivityStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribePrivateConnectivity(ctx, stack, &v2.DescribePrivateConnectivityParams{})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		endpoints := make([]v2.PrivateConnectivityEndpoints, 0)
+		if resp.StatusCode == http.StatusOK {
+			var privateConnectivity v2.DescribePrivateConnectivity
+			if err = json.Unmarshal(bodyBytes, &privateConnectivity); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if privateConnectivity.Endpoints != nil {
+				endpoints = *privateConnectivity.Endpoints
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return endpoints, status, nil
+	}
+}
+
+// PrivateConnectivityStatusEndpointsReady returns StateRefreshFunc that makes GET request and checks if the endpoint of
+// every requested feature is ready and allows exactly the requested customer accounts, errors if an endpoint has failed
+func PrivateConnectivityStatusEndpointsReady(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, features []string, customerAccountIDs []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, statusText, err := PrivateConnectivityStatusRead(ctx, acsClient, stack)()
+		if err != nil {
+			// endpoints are not returned until the enable request has been processed
+			if statusText == http.StatusText(http.StatusNotFound) {
+				return nil, EndpointsPendingStatus, nil
+			}
+			return output, statusText, err
+		}
+		if statusText != http.StatusText(http.StatusOK) {
+			return output, statusText, nil
+		}
+
+		endpoints := output.([]v2.PrivateConnectivityEndpoints)
+		ready, err := EndpointsReady(endpoints, features, customerAccountIDs)
+		if err != nil {
+			return nil, EndpointsPendingStatus, err
+		}
+		if ready {
+			return endpoints, EndpointsReadyStatus, nil
+		}
+		return endpoints, EndpointsPendingStatus, nil
+	}
+}
+
+// EndpointsReady checks that every feature has a ready endpoint allowing exactly the customer account IDs, an error is
+// returned if the endpoint of a feature has failed
+func EndpointsReady(endpoints []v2.PrivateConnectivityEndpoints, features []string, customerAccountIDs []string) (bool, error) {
+	for _, feature := range features {
+		endpoint := FindEndpoint(endpoints, feature)
+		if endpoint == nil || endpoint.Status == nil {
+			return false, nil
+		}
+		if strings.EqualFold(*endpoint.Status, EndpointStatusFailed) {
+			return false, fmt.Errorf("endpoint for feature (%s) failed: %s", feature, endpointFailureReason(endpoint))
+		}
+		if !strings.EqualFold(*endpoint.Status, EndpointStatusReady) {
+			return false, nil
+		}
+
+		// removed accounts must no longer be allowed, so the allowed accounts have to equal the requested ones
+		if !utils.IsSliceEqual(uniqueAccountIDs(endpoint.CustomerAccountIds), uniqueAccountIDs(&customerAccountIDs)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// uniqueAccountIDs returns the account IDs without duplicates
+func uniqueAccountIDs(accountIDs *[]string) *[]string {
+	unique := make([]string, 0)
+	if accountIDs == nil {
+		return &unique
+	}
+	seen := make(map[string]bool)
+	for _, accountID := range *accountIDs {
+		if !seen[accountID] {
+			seen[accountID] = true
+			unique = append(unique, accountID)
+		}
+	}
+	return &unique
+}
+
+// FindEndpoint returns the endpoint of the feature or nil if the feature has no endpoint
+func FindEndpoint(endpoints []v2.PrivateConnectivityEndpoints, feature string) *v2.PrivateConnectivityEndpoints {
+	for i := range endpoints {
+		if endpoints[i].Feature != nil && *endpoints[i].Feature == feature {
+			return &endpoints[i]
+		}
+	}
+	return nil
+}
+
+func endpointFailureReason(endpoint *v2.PrivateConnectivityEndpoints) string {
+	var details []string
+	if endpoint.Reason != nil && *endpoint.Reason != "" {
+		details = append(details, *endpoint.Reason)
+	}
+	if endpoint.Message != nil && *endpoint.Message != "" {
+		details = append(details, *endpoint.Message)
+	}
+	if len(details) == 0 {
+		return "no reason returned"
+	}
+	return strings.Join(details, ": ")
+}
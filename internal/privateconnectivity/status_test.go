@@ -0,0 +1,172 @@
+package privateconnectivity_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_EligibilityStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		eligible := true
+		b, _ := json.Marshal(v2.DescribeEligibilityPrivateConnectivity{Eligible: &eligible})
+		client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genResp(http.StatusOK, b), nil).Once()
+		output, statusText, err := privateconnectivity.EligibilityStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.True(t, *output.(*v2.DescribeEligibilityPrivateConnectivity).Eligible)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genEndpointsResp(http.StatusForbidden, nil), nil).Once()
+		output, _, err := privateconnectivity.EligibilityStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_PrivateConnectivityStatusEnable(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockEnableRequest).Return(genEndpointsResp(http.StatusAccepted, nil), nil).Once()
+		_, statusText, err := privateconnectivity.PrivateConnectivityStatusEnable(context.TODO(), client, v2.Stack(mockStack), mockEnableRequest)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := privateconnectivity.PrivateConnectivityStatusEnable(context.TODO(), client, v2.Stack(mockStack), mockEnableRequest)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_PrivateConnectivityStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+		output, statusText, err := privateconnectivity.PrivateConnectivityStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Len(t, output.([]v2.PrivateConnectivityEndpoints), 2)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusNotFound, nil), nil).Once()
+		_, statusText, err := privateconnectivity.PrivateConnectivityStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+}
+
+func Test_PrivateConnectivityStatusEndpointsReady(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with endpoints not found yet", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusNotFound, nil), nil).Once()
+		_, statusText, err := privateconnectivity.PrivateConnectivityStatusEndpointsReady(context.TODO(), client, v2.Stack(mockStack), mockFeatures, mockAccountIDs)()
+		assert.NoError(t, err)
+		assert.Equal(t, privateconnectivity.EndpointsPendingStatus, statusText)
+	})
+
+	t.Run("with endpoints ready", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints("ready")), nil).Once()
+		_, statusText, err := privateconnectivity.PrivateConnectivityStatusEndpointsReady(context.TODO(), client, v2.Stack(mockStack), mockFeatures, mockAccountIDs)()
+		assert.NoError(t, err)
+		assert.Equal(t, privateconnectivity.EndpointsReadyStatus, statusText)
+	})
+
+	t.Run("with endpoints failed", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(http.StatusOK, genEndpoints(privateconnectivity.EndpointStatusFailed)), nil).Once()
+		_, _, err := privateconnectivity.PrivateConnectivityStatusEndpointsReady(context.TODO(), client, v2.Stack(mockStack), mockFeatures, mockAccountIDs)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_EndpointsReady(t *testing.T) {
+	endpoints := genEndpoints(privateconnectivity.EndpointStatusReady)
+
+	ready, err := privateconnectivity.EndpointsReady(endpoints, mockFeatures, mockAccountIDs)
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	// account not yet allowed
+	ready, err = privateconnectivity.EndpointsReady(endpoints, mockFeatures, append([]string{"999999999999"}, mockAccountIDs...))
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	// account removed but still allowed
+	ready, err = privateconnectivity.EndpointsReady(endpoints, mockFeatures, mockAccountIDs[:1])
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	// account removed and no longer allowed
+	remainingAccountIDs := []string{mockAccountIDs[0]}
+	for i := range endpoints {
+		endpoints[i].CustomerAccountIds = &remainingAccountIDs
+	}
+	ready, err = privateconnectivity.EndpointsReady(endpoints, mockFeatures, []string{mockAccountIDs[0], mockAccountIDs[0]})
+	assert.NoError(t, err)
+	assert.True(t, ready)
+	endpoints = genEndpoints(privateconnectivity.EndpointStatusReady)
+
+	// feature without endpoint
+	ready, err = privateconnectivity.EndpointsReady(endpoints[:1], mockFeatures, mockAccountIDs)
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	// endpoint still provisioning
+	ready, err = privateconnectivity.EndpointsReady(genEndpoints("Pending"), mockFeatures, mockAccountIDs)
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	_, err = privateconnectivity.EndpointsReady(genEndpoints(privateconnectivity.EndpointStatusFailed), mockFeatures, mockAccountIDs)
+	assert.ErrorContains(t, err, "quota exceeded")
+}
+
+func genEndpoints(status string) []v2.PrivateConnectivityEndpoints {
+	search, ingest := privateconnectivity.FeatureSearch, privateconnectivity.FeatureIngest
+	endpoint := "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0"
+	reason := "quota exceeded"
+	dnsRecords := []string{"mock-stack.private.splunkcloud.com"}
+	return []v2.PrivateConnectivityEndpoints{
+		{Feature: &search, Status: &status, Endpoint: &endpoint, CustomerAccountIds: &mockAccountIDs, PrivateSearchDNSRecords: &dnsRecords, Reason: &reason},
+		{Feature: &ingest, Status: &status, Endpoint: &endpoint, CustomerAccountIds: &mockAccountIDs, Reason: &reason},
+	}
+}
+
+func genEndpointsResp(code int, endpoints []v2.PrivateConnectivityEndpoints) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(v2.DescribePrivateConnectivity{Endpoints: &endpoints})
+	} else if code != http.StatusAccepted {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genResp(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,98 @@
+package privateconnectivity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	PendingStatusEndpointsReady = []string{EndpointsPendingStatus, http.StatusText(http.StatusTooManyRequests)}
+	TargetStatusEndpointsReady  = []string{EndpointsReadyStatus}
+)
+
+// WaitEligibilityRead Handles retry logic for GET requests checking the private connectivity eligibility of the stack
+func WaitEligibilityRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.DescribeEligibilityPrivateConnectivity, error) {
+	waitEligibilityRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, EligibilityStatusRead(ctx, acsClient, stack))
+
+	output, err := waitEligibilityRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading private connectivity eligibility: %s", err))
+		return nil, err
+	}
+	eligibility := output.(*v2.DescribeEligibilityPrivateConnectivity)
+
+	return eligibility, nil
+}
+
+// WaitPrivateConnectivityEnable Handles retry logic for POST requests for the create lifecycle function
+func WaitPrivateConnectivityEnable(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, enableRequest v2.EnablePrivateConnectivityJSONRequestBody) error {
+	waitPrivateConnectivityEnableAccepted := wait.GenerateWriteStateChangeConf(PrivateConnectivityStatusEnable(ctx, acsClient, stack, enableRequest))
+
+	rawResp, err := waitPrivateConnectivityEnableAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for private connectivity to be enabled: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and creation in progress
+	tflog.Info(ctx, fmt.Sprintf("Enable response status code for private connectivity: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for private connectivity: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitPrivateConnectivityUpdate Handles retry logic for PATCH requests for the update lifecycle function
+func WaitPrivateConnectivityUpdate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, updateRequest v2.UpdatePrivateConnectivityJSONRequestBody) error {
+	waitPrivateConnectivityUpdateAccepted := wait.GenerateWriteStateChangeConf(PrivateConnectivityStatusUpdate(ctx, acsClient, stack, updateRequest))
+
+	rawResp, err := waitPrivateConnectivityUpdateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for private connectivity to be updated: %s", err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	// Log to user that request submitted and update in progress
+	tflog.Info(ctx, fmt.Sprintf("Update response status code for private connectivity: %d\n", resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for private connectivity: %s\n", resp.Header.Get("X-REQUEST-ID")))
+
+	return nil
+}
+
+// WaitPrivateConnectivityRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPrivateConnectivityRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.PrivateConnectivityEndpoints, error) {
+	waitPrivateConnectivityRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PrivateConnectivityStatusRead(ctx, acsClient, stack))
+
+	output, err := waitPrivateConnectivityRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading private connectivity: %s", err))
+		return nil, err
+	}
+	endpoints := output.([]v2.PrivateConnectivityEndpoints)
+
+	return endpoints, nil
+}
+
+// WaitEndpointsReady Handles retry logic for polling the endpoints until the endpoint of every feature is ready,
+// provisioning endpoints may take longer than other operations so the timeout of the lifecycle function is used
+func WaitEndpointsReady(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, features []string, customerAccountIDs []string, timeout time.Duration) error {
+	waitEndpointsReady := wait.GenerateReadStateChangeConf(PendingStatusEndpointsReady, TargetStatusEndpointsReady, PrivateConnectivityStatusEndpointsReady(ctx, acsClient, stack, features, customerAccountIDs))
+	waitEndpointsReady.Timeout = timeout
+
+	_, err := waitEndpointsReady.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for private connectivity endpoints to be ready: %s", err))
+		return err
+	}
+
+	return nil
+}
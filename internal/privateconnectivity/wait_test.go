@@ -0,0 +1,119 @@
+package privateconnectivity_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+)
+
+var (
+	mockFeatures          = []string{privateconnectivity.FeatureSearch, privateconnectivity.FeatureIngest}
+	mockAccountIDs        = []string{"123456789012", "210987654321"}
+	mockFeaturesParameter = v2.PrivateConnectivityFeatures(mockFeatures)
+	mockEnableRequest     = v2.EnablePrivateConnectivityJSONRequestBody{CustomerAccountIds: &mockAccountIDs, Feature: &mockFeaturesParameter}
+	mockUpdateRequest     = v2.UpdatePrivateConnectivityJSONRequestBody{CustomerAccountIds: &mockAccountIDs, Feature: &mockFeaturesParameter}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitPrivateConnectivityEnable(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, v2.Stack(mockStack), mockEnableRequest)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockEnableRequest).Return(genEndpointsResp(429, nil), nil).Once()
+		client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockEnableRequest).Return(genEndpointsResp(202, nil), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, v2.Stack(mockStack), mockEnableRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("EnablePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(statusCode, nil), nil).Once()
+				err := privateconnectivity.WaitPrivateConnectivityEnable(context.TODO(), client, v2.Stack(mockStack), mockEnableRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPrivateConnectivityUpdate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("UpdatePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mockUpdateRequest).Return(genEndpointsResp(202, nil), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateRequest)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("UpdatePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(400, nil), nil).Once()
+		err := privateconnectivity.WaitPrivateConnectivityUpdate(context.TODO(), client, v2.Stack(mockStack), mockUpdateRequest)
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitEligibilityRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ValidatePrivateConnectivity", mock.Anything, v2.Stack(mockStack)).Return(genEndpointsResp(statusCode, nil), nil).Once()
+				_, err := privateconnectivity.WaitEligibilityRead(context.TODO(), client, v2.Stack(mockStack))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPrivateConnectivityRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(429, nil), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(200, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+		endpoints, err := privateconnectivity.WaitPrivateConnectivityRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.Len(t, endpoints, 2)
+	})
+}
+
+func Test_WaitEndpointsReady(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with endpoints pending then ready", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(404, nil), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(200, genEndpoints("Pending")), nil).Once()
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(200, genEndpoints(privateconnectivity.EndpointStatusReady)), nil).Once()
+		err := privateconnectivity.WaitEndpointsReady(context.TODO(), client, v2.Stack(mockStack), mockFeatures, mockAccountIDs, time.Minute)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with endpoints failed", func(t *testing.T) {
+		client.On("DescribePrivateConnectivity", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genEndpointsResp(200, genEndpoints(privateconnectivity.EndpointStatusFailed)), nil).Once()
+		err := privateconnectivity.WaitEndpointsReady(context.TODO(), client, v2.Stack(mockStack), mockFeatures, mockAccountIDs, time.Minute)
+		assert.Error(t, err)
+	})
+}
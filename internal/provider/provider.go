@@ -10,15 +10,32 @@ import (
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/appinspect"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/appfeatures"
+	"github.com/splunk/terraform-provider-scp/internal/apppermissions"
+	"github.com/splunk/terraform-provider-scp/internal/apps"
 	"github.com/splunk/terraform-provider-scp/internal/appvalidation"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/emek"
 	"github.com/splunk/terraform-provider-scp/internal/hec"
 	"github.com/splunk/terraform-provider-scp/internal/indexes"
 	"github.com/splunk/terraform-provider-scp/internal/ipallowlists"
 	"github.com/splunk/terraform-provider-scp/internal/ipv6allowlists"
+	"github.com/splunk/terraform-provider-scp/internal/limits"
+	"github.com/splunk/terraform-provider-scp/internal/maintenancewindows"
+	"github.com/splunk/terraform-provider-scp/internal/managedglue"
+	"github.com/splunk/terraform-provider-scp/internal/observability"
+	"github.com/splunk/terraform-provider-scp/internal/outboundports"
+	"github.com/splunk/terraform-provider-scp/internal/outboundportsv6"
 	privateapps "github.com/splunk/terraform-provider-scp/internal/private_apps"
+	"github.com/splunk/terraform-provider-scp/internal/privateconnectivity"
+	"github.com/splunk/terraform-provider-scp/internal/pythonversion"
 	"github.com/splunk/terraform-provider-scp/internal/roles"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
 	splunkbaseapps "github.com/splunk/terraform-provider-scp/internal/splunkbase_apps"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+	"github.com/splunk/terraform-provider-scp/internal/tokens"
 	"github.com/splunk/terraform-provider-scp/internal/users"
+	"github.com/splunk/terraform-provider-scp/internal/workflows"
 )
 
 func init() {
@@ -56,22 +73,52 @@ func New(version string) func() *schema.Provider {
 // Returns a map of splunk resources for configuration
 func providerResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		indexes.ResourceKey:        indexes.ResourceIndex(),
-		hec.ResourceKey:            hec.ResourceHecToken(),
-		ipallowlists.ResourceKey:   ipallowlists.ResourceIPAllowlist(),
-		ipv6allowlists.ResourceKey: ipv6allowlists.ResourceIPv6Allowlist(),
-		roles.ResourceKey:          roles.ResourceRole(),
-		users.ResourceKey:          users.ResourceUser(),
-		splunkbaseapps.ResourceKey: splunkbaseapps.ResourceSplunkbaseApp(),
-		privateapps.ResourceKey:    privateapps.ResourcePrivateApp(),
+		indexes.ResourceKey:             indexes.ResourceIndex(),
+		hec.ResourceKey:                 hec.ResourceHecToken(),
+		ipallowlists.ResourceKey:        ipallowlists.ResourceIPAllowlist(),
+		ipv6allowlists.ResourceKey:      ipv6allowlists.ResourceIPv6Allowlist(),
+		roles.ResourceKey:               roles.ResourceRole(),
+		users.ResourceKey:               users.ResourceUser(),
+		splunkbaseapps.ResourceKey:      splunkbaseapps.ResourceSplunkbaseApp(),
+		privateapps.ResourceKey:         privateapps.ResourcePrivateApp(),
+		outboundports.ResourceKey:       outboundports.ResourceOutboundPort(),
+		outboundportsv6.ResourceKey:     outboundportsv6.ResourceOutboundPortV6(),
+		limits.ResourceKey:              limits.ResourceLimitsConfig(),
+		maintenancewindows.ResourceKey:  maintenancewindows.ResourcePreferences(),
+		selfstorage.ResourceKey:         selfstorage.ResourceSelfStorageLocation(),
+		pythonversion.ResourceKey:       pythonversion.ResourcePythonVersion(),
+		apppermissions.ResourceKey:      apppermissions.ResourceAppPermissions(),
+		appfeatures.ResourceKey:         appfeatures.ResourceAppFeatureEnablement(),
+		privateconnectivity.ResourceKey: privateconnectivity.ResourcePrivateConnectivity(),
+		emek.ResourceKey:                emek.ResourceEmekKey(),
+		managedglue.ResourceKey:         managedglue.ResourceManagedGlueResources(),
+		tokens.ResourceKey:              tokens.ResourceToken(),
+		stacks.ResourceKeyRestart:       stacks.ResourceStackRestart(),
+		observability.ResourceKey:       observability.ResourceObservabilityPairing(),
 	}
 }
 
 // Returns a map of Splunk data sources for configuration
 func providerDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		indexes.ResourceKey:         indexes.DataSourceIndex(),
-		appvalidation.DataSourceKey: appvalidation.DataSourcePrivateAppValidation(),
+		indexes.ResourceKey:                       indexes.DataSourceIndex(),
+		appvalidation.DataSourceKey:               appvalidation.DataSourcePrivateAppValidation(),
+		maintenancewindows.DataSourceKeySchedules: maintenancewindows.DataSourceSchedules(),
+		selfstorage.DataSourceKeyPrefix:           selfstorage.DataSourcePrefix(),
+		selfstorage.DataSourceKeyServiceAccounts:  selfstorage.DataSourceServiceAccounts(),
+		selfstorage.DataSourceKeyPolicy:           selfstorage.DataSourcePolicy(),
+		emek.DataSourceKeyPolicy:                  emek.DataSourcePolicy(),
+		stacks.DataSourceKey:                      stacks.DataSourceStack(),
+		roles.DataSourceKeyCapabilities:           roles.DataSourceCapabilities(),
+		indexes.DataSourceKeyList:                 indexes.DataSourceIndexesList(),
+		hec.DataSourceKeyList:                     hec.DataSourceHecTokensList(),
+		users.DataSourceKey:                       users.DataSourceUser(),
+		users.DataSourceKeyList:                   users.DataSourceUsersList(),
+		roles.DataSourceKey:                       roles.DataSourceRole(),
+		roles.DataSourceKeyList:                   roles.DataSourceRolesList(),
+		apps.DataSourceKey:                        apps.DataSourceApps(),
+		deployments.DataSourceKey:                 deployments.DataSourceLastDeployment(),
+		workflows.DataSourceKey:                   workflows.DataSourceWorkflow(),
 	}
 }
 
@@ -0,0 +1,138 @@
+package pythonversion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+)
+
+const (
+	ResourceKey = "scp_python_version"
+
+	schemaKeyPythonVersion = "python_version"
+)
+
+func pythonVersionResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPythonVersion: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "The Python version the stack should use (for example `python3.9`). Changing the version triggers a " +
+				"rolling restart of the stack, Terraform waits until the restart has completed.",
+		},
+	}
+}
+
+func ResourcePythonVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Python Version Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManagePythonVersion " +
+			"for more latest, detailed information on attribute requirements and the ACS Python Version API.",
+
+		CreateContext: resourcePythonVersionCreate,
+		ReadContext:   resourcePythonVersionRead,
+		UpdateContext: resourcePythonVersionUpdate,
+		DeleteContext: resourcePythonVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: pythonVersionResourceSchema(),
+	}
+}
+
+func resourcePythonVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pythonVersion := d.Get(schemaKeyPythonVersion).(string)
+
+	if diags := changePythonVersion(ctx, acsClient, stack, pythonVersion); diags != nil {
+		return diags
+	}
+
+	// The python version is a single setting per stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created python version resource: %s\n", pythonVersion))
+
+	return resourcePythonVersionRead(ctx, d, m)
+}
+
+func resourcePythonVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	pythonVersion, err := WaitPythonVersionRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading python version: %s", err)
+	}
+
+	if err := d.Set(schemaKeyPythonVersion, pythonVersion.PythonVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePythonVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	if d.HasChange(schemaKeyPythonVersion) {
+		pythonVersion := d.Get(schemaKeyPythonVersion).(string)
+		if diags := changePythonVersion(ctx, acsClient, stack, pythonVersion); diags != nil {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated python version resource: %s\n", pythonVersion))
+	}
+
+	return resourcePythonVersionRead(ctx, d, m)
+}
+
+func resourcePythonVersionDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The python version can not be unset, the stack keeps its current version and the resource is only removed from state
+	tflog.Warn(ctx, fmt.Sprintf("Python version resource (%s) removed from state only. The stack keeps its current python version.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+// changePythonVersion changes the python version when it differs from the current version and waits for the resulting
+// rolling restart, errors include the ACS request ID of the change for troubleshooting with Splunk support
+func changePythonVersion(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) diag.Diagnostics {
+	current, err := WaitPythonVersionRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading python version: %s", err)
+	}
+	if current.PythonVersion != nil && *current.PythonVersion == pythonVersion {
+		tflog.Info(ctx, fmt.Sprintf("Python version is already %s, no change required", pythonVersion))
+		return nil
+	}
+
+	var requestID string
+	err = deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() (err error) {
+		requestID, err = WaitPythonVersionChange(ctx, acsClient, stack, pythonVersion)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for python version (%s) to be changed: %s", pythonVersion, err)
+	}
+
+	if err = stacks.WaitRestartComplete(ctx, acsClient, stack); err != nil {
+		return diag.Errorf("Error waiting for stack restart after changing python version (%s), ACS Request ID (%s): %s", pythonVersion, requestID, err)
+	}
+	return nil
+}
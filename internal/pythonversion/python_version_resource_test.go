@@ -0,0 +1,97 @@
+package pythonversion_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/pythonversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR PYTHON VERSION RESOURCE
+
+Changing the python version triggers a rolling restart of the acceptance test stack, which interrupts the searches of
+every other acceptance test running against it. The change and the restart wait are covered against a mocked client below.
+*/
+
+func Test_ResourcePythonVersionCreate(t *testing.T) {
+	t.Run("with version change waits for restart to start and complete", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, "python3.7"), nil).Once()
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.ChangePythonVersionJSONRequestBody) bool {
+			return body.PythonVersion != nil && *body.PythonVersion == mockPythonVersion
+		})).Return(genPythonVersionResp(http.StatusAccepted, ""), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(false, true), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(true, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackReadyResp(), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, mockPythonVersion), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, pythonversion.ResourcePythonVersion().Schema, map[string]interface{}{"python_version": mockPythonVersion})
+		diags := pythonversion.ResourcePythonVersion().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, mockStack, d.Id())
+		assert.Equal(t, mockPythonVersion, d.Get("python_version"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with version already set does not wait for restart", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, mockPythonVersion), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, mockPythonVersion), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, pythonversion.ResourcePythonVersion().Schema, map[string]interface{}{"python_version": mockPythonVersion})
+		diags := pythonversion.ResourcePythonVersion().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, mockStack, d.Id())
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with rejected version change", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, "python3.7"), nil).Once()
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genPythonVersionResp(http.StatusBadRequest, ""), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, pythonversion.ResourcePythonVersion().Schema, map[string]interface{}{"python_version": mockPythonVersion})
+		diags := pythonversion.ResourcePythonVersion().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.True(t, diags.HasError())
+		assert.Empty(t, d.Id())
+		client.AssertExpectations(t)
+	})
+}
+
+func genACSProvider(acsClient v2.ClientInterface) client.ACSProvider {
+	return client.ACSProvider{Client: &acsClient, Stack: v2.Stack(mockStack)}
+}
+
+func genRestartStatusResp(rollingRestartInitiated bool, serviceReady bool) *http.Response {
+	b, _ := json.Marshal(map[string]interface{}{"shcStatus": []v2.RestartStatus{
+		{RollingRestartInitiated: &rollingRestartInitiated, ServiceReady: &serviceReady},
+	}})
+	return genJSONResp(http.StatusOK, b)
+}
+
+func genStackReadyResp() *http.Response {
+	ready := "Ready"
+	stackStatus := v2.StackStatus{}
+	stackStatus.Infrastructure.Status = &ready
+	b, _ := json.Marshal(map[string]interface{}{"status": stackStatus})
+	return genJSONResp(http.StatusOK, b)
+}
+
+func genJSONResp(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
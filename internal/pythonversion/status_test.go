@@ -0,0 +1,82 @@
+package pythonversion_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/pythonversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_PythonVersionStatusChange(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(body v2.ChangePythonVersionJSONRequestBody) bool {
+			return body.PythonVersion != nil && *body.PythonVersion == mockPythonVersion
+		})).Return(genPythonVersionResp(http.StatusAccepted, ""), nil).Once()
+		_, statusText, err := pythonversion.PythonVersionStatusChange(context.TODO(), client, v2.Stack(mockStack), mockPythonVersion)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := pythonversion.PythonVersionStatusChange(context.TODO(), client, v2.Stack(mockStack), mockPythonVersion)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_PythonVersionStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusOK, mockPythonVersion), nil).Once()
+		output, statusText, err := pythonversion.PythonVersionStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockPythonVersion, *output.(*v2.PythonVersionResponse).PythonVersion)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusTooManyRequests, ""), nil).Once()
+		_, statusText, err := pythonversion.PythonVersionStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(http.StatusForbidden, ""), nil).Once()
+		output, statusText, err := pythonversion.PythonVersionStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusForbidden), statusText)
+	})
+}
+
+func genPythonVersionResp(code int, pythonVersion string) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		b, _ = json.Marshal(v2.PythonVersionResponse{PythonVersion: &pythonVersion})
+	} else if code != http.StatusAccepted {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.Header().Add("X-REQUEST-ID", mockRequestID)
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,45 @@
+package pythonversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// WaitPythonVersionChange Handles retry logic for POST requests for create/update lifecycle functions, returns the ACS request ID of the accepted change
+func WaitPythonVersionChange(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, pythonVersion string) (string, error) {
+	waitPythonVersionChangeAccepted := wait.GenerateWriteStateChangeConf(PythonVersionStatusChange(ctx, acsClient, stack, pythonVersion))
+
+	rawResp, err := waitPythonVersionChangeAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for python version (%s) to be changed: %s", pythonVersion, err))
+		return "", err
+	}
+
+	resp := rawResp.(*http.Response)
+	requestID := resp.Header.Get("X-REQUEST-ID")
+
+	// Log to user that request submitted and change in progress
+	tflog.Info(ctx, fmt.Sprintf("Change response status code for python version (%s): %d\n", pythonVersion, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for python version (%s): %s\n", pythonVersion, requestID))
+
+	return requestID, nil
+}
+
+// WaitPythonVersionRead Handles retry logic for GET requests for the read lifecycle function
+func WaitPythonVersionRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.PythonVersionResponse, error) {
+	waitPythonVersionRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PythonVersionStatusRead(ctx, acsClient, stack))
+
+	output, err := waitPythonVersionRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading python version: %s", err))
+		return nil, err
+	}
+	pythonVersion := output.(*v2.PythonVersionResponse)
+
+	return pythonVersion, nil
+}
@@ -0,0 +1,75 @@
+package pythonversion_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/pythonversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack         = "mock-stack"
+	mockPythonVersion = "python3.9"
+	mockRequestID     = "mock-request-id"
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitPythonVersionChange(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, err := pythonversion.WaitPythonVersionChange(context.TODO(), client, v2.Stack(mockStack), mockPythonVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genPythonVersionResp(429, ""), nil).Once()
+		client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genPythonVersionResp(202, ""), nil).Once()
+		requestID, err := pythonversion.WaitPythonVersionChange(context.TODO(), client, v2.Stack(mockStack), mockPythonVersion)
+		assert.NoError(t, err)
+		assert.Equal(t, mockRequestID, requestID)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("ChangePythonVersion", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genPythonVersionResp(statusCode, ""), nil).Once()
+				_, err := pythonversion.WaitPythonVersionChange(context.TODO(), client, v2.Stack(mockStack), mockPythonVersion)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitPythonVersionRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(429, ""), nil).Once()
+		client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(200, mockPythonVersion), nil).Once()
+		pythonVersion, err := pythonversion.WaitPythonVersionRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.Equal(t, mockPythonVersion, *pythonVersion.PythonVersion)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetPythonVersion", mock.Anything, v2.Stack(mockStack)).Return(genPythonVersionResp(statusCode, ""), nil).Once()
+				_, err := pythonversion.WaitPythonVersionRead(context.TODO(), client, v2.Stack(mockStack))
+				assert.Error(t, err)
+			})
+		}
+	})
+}
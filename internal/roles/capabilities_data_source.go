@@ -0,0 +1,83 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyCapabilities = "scp_capabilities"
+
+	schemaKeyGrantableOnly         = "grantable_only"
+	schemaKeyGrantableCapabilities = "grantable_capabilities"
+	schemaKeySystemCapabilities    = "system_capabilities"
+)
+
+func capabilitiesDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyGrantableOnly: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to only return the capabilities that can be granted to roles.",
+		},
+		schemaKeyGrantableCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities that can be granted to roles through ACS.",
+		},
+		schemaKeySystemCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities reserved for the system that can not be granted to roles. Empty when `grantable_only` is true.",
+		},
+	}
+}
+
+func DataSourceCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Description: "Capabilities Data Source. Use this data source to list the capabilities of the stack, for example to " +
+			"check which capabilities can be granted to an `scp_roles` resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageRoles " +
+			"for more latest, detailed information on attribute requirements and the ACS Capabilities API.",
+
+		ReadContext: dataSourceCapabilitiesRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: capabilitiesDataSourceSchema(),
+	}
+}
+
+func dataSourceCapabilitiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	grantableOnly := d.Get(schemaKeyGrantableOnly).(bool)
+
+	capabilities, err := WaitCapabilitiesList(ctx, acsClient, stack, grantableOnly)
+	if err != nil {
+		return diag.Errorf("Error reading capabilities: %s", err)
+	}
+
+	if err := d.Set(schemaKeyGrantableCapabilities, capabilities.GrantableCapabilities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeySystemCapabilities, capabilities.SystemCapabilities); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%t", stack, grantableOnly))
+
+	return nil
+}
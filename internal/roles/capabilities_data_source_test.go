@@ -0,0 +1,28 @@
+package roles_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const capabilitiesDataSourceTemplate = `
+data "scp_capabilities" "test" {}
+`
+
+func TestAcc_SplunkCloudCapabilities_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: capabilitiesDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_capabilities.test", "grantable_capabilities.#"),
+					resource.TestCheckResourceAttrSet("data.scp_capabilities.test", "system_capabilities.#"),
+				),
+			},
+		},
+	})
+}
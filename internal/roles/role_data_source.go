@@ -0,0 +1,179 @@
+package roles
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKey = "scp_role"
+
+	schemaKeyImportedCapabilities  = "imported_capabilities"
+	schemaKeyEffectiveCapabilities = "effective_capabilities"
+)
+
+// roleAttributesSchema returns the computed attributes of a role as returned by ACS
+func roleAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the role.",
+		},
+		schemaKeyCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities assigned directly to the role.",
+		},
+		schemaKeyImportedRoles: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The roles the role imports, including roles imported indirectly.",
+		},
+		schemaKeyImportedCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities the role inherits from its imported roles.",
+		},
+		schemaKeyEffectiveCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities assigned directly to the role and inherited from its imported roles.",
+		},
+		schemaKeyDefaultApp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The default app of the role.",
+		},
+		schemaKeySrchIndexesAllowed: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The indexes the role is allowed to search.",
+		},
+		schemaKeySrchIndexesDefault: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The indexes searched by default when no index is specified.",
+		},
+		schemaKeySrchFilter: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The search filter applied to searches of the role.",
+		},
+	}
+}
+
+func roleDataSourceSchema() map[string]*schema.Schema {
+	attributes := roleAttributesSchema()
+	attributes[schemaKeyName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the role.",
+	}
+	return attributes
+}
+
+func DataSourceRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Role Data Source. Use this data source to review the effective capabilities and imported roles of a role " +
+			"you do not wish Terraform to execute write operations on. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageRoles " +
+			"for more latest, detailed information on attribute requirements and the ACS Roles API.",
+
+		ReadContext: dataSourceRoleRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: roleDataSourceSchema(),
+	}
+}
+
+func dataSourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	roleName := d.Get(schemaKeyName).(string)
+
+	role, err := WaitRoleRead(ctx, acsClient, stack, roleName)
+	if err != nil {
+		return diag.Errorf("Error reading role (%s): %s", roleName, err)
+	}
+
+	for key, value := range FlattenRole(*role) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return nil
+}
+
+// FlattenRole converts a role response to the map of attributes defined by roleAttributesSchema
+func FlattenRole(role v2.RolesResponse) map[string]interface{} {
+	capabilities := stringSliceValue(role.Capabilities)
+	importedRoles := make([]string, 0)
+	importedCapabilities := make([]string, 0)
+	if role.Imported != nil {
+		importedRoles = stringSliceValue(role.Imported.Roles)
+		importedCapabilities = stringSliceValue(role.Imported.Capabilities)
+	}
+
+	defaultApp := ""
+	if role.DefaultApp != nil {
+		defaultApp = *role.DefaultApp
+	}
+	srchFilter := ""
+	if role.SrchFilter != nil {
+		srchFilter = *role.SrchFilter
+	}
+
+	return map[string]interface{}{
+		schemaKeyName:                  role.Name,
+		schemaKeyCapabilities:          capabilities,
+		schemaKeyImportedRoles:         importedRoles,
+		schemaKeyImportedCapabilities:  importedCapabilities,
+		schemaKeyEffectiveCapabilities: EffectiveCapabilities(capabilities, importedCapabilities),
+		schemaKeyDefaultApp:            defaultApp,
+		schemaKeySrchIndexesAllowed:    stringSliceValue(role.SrchIndexesAllowed),
+		schemaKeySrchIndexesDefault:    stringSliceValue(role.SrchIndexesDefault),
+		schemaKeySrchFilter:            srchFilter,
+	}
+}
+
+// EffectiveCapabilities returns the sorted union of the capabilities of a role and the capabilities it imports
+func EffectiveCapabilities(capabilities []string, importedCapabilities []string) []string {
+	seen := make(map[string]bool)
+	effective := make([]string, 0, len(capabilities)+len(importedCapabilities))
+	for _, capability := range append(append([]string{}, capabilities...), importedCapabilities...) {
+		if seen[capability] {
+			continue
+		}
+		seen[capability] = true
+		effective = append(effective, capability)
+	}
+	sort.Strings(effective)
+	return effective
+}
+
+func stringSliceValue(values *[]string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+	return *values
+}
@@ -0,0 +1,69 @@
+package roles_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/roles"
+	"github.com/stretchr/testify/assert"
+)
+
+const roleDataSourceTemplate = `
+data "scp_role" "test" {
+	name = "sc_admin"
+}
+`
+
+func TestAcc_SplunkCloudRole_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: roleDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scp_role.test", "name", "sc_admin"),
+					resource.TestCheckResourceAttrSet("data.scp_role.test", "effective_capabilities.#"),
+					resource.TestCheckResourceAttrSet("data.scp_role.test", "imported_roles.#"),
+				),
+			},
+		},
+	})
+}
+
+func Test_EffectiveCapabilities(t *testing.T) {
+	t.Run("with overlapping capabilities", func(t *testing.T) {
+		assert.Equal(t, []string{"edit_user", "schedule_search", "search"},
+			roles.EffectiveCapabilities([]string{"search", "edit_user"}, []string{"schedule_search", "search"}))
+	})
+
+	t.Run("without capabilities", func(t *testing.T) {
+		assert.Empty(t, roles.EffectiveCapabilities(nil, nil))
+	})
+}
+
+func Test_FlattenRole(t *testing.T) {
+	t.Run("with imported roles", func(t *testing.T) {
+		role := v2.RolesResponse{
+			Name:     mockRoleName,
+			Imported: &v2.ImportedRolesInfo{Roles: &[]string{"user"}},
+		}
+		role.Capabilities = &[]string{"edit_user"}
+		role.Imported.Capabilities = &[]string{"search"}
+
+		flattened := roles.FlattenRole(role)
+		assert.Equal(t, mockRoleName, flattened["name"])
+		assert.Equal(t, []string{"user"}, flattened["imported_roles"])
+		assert.Equal(t, []string{"search"}, flattened["imported_capabilities"])
+		assert.Equal(t, []string{"edit_user", "search"}, flattened["effective_capabilities"])
+	})
+
+	t.Run("without imported roles", func(t *testing.T) {
+		flattened := roles.FlattenRole(v2.RolesResponse{Name: mockRoleName})
+		assert.Equal(t, []string{}, flattened["imported_roles"])
+		assert.Equal(t, []string{}, flattened["effective_capabilities"])
+		assert.Equal(t, "", flattened["default_app"])
+	})
+}
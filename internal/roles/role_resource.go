@@ -3,6 +3,7 @@ package roles
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/status"
 	"github.com/splunk/terraform-provider-scp/internal/utils"
@@ -162,6 +164,7 @@ func ResourceRole() *schema.Resource {
 		ReadContext:   resourceRoleRead,
 		UpdateContext: resourceRoleUpdate,
 		DeleteContext: resourceRoleDelete,
+		CustomizeDiff: customizeRoleDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -189,7 +192,9 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	tflog.Info(ctx, fmt.Sprintf("%+v\n", createRequest))
 
-	err := WaitRoleCreate(ctx, acsClient, stack, createParam, createRequest)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitRoleCreate(ctx, acsClient, stack, createParam, createRequest)
+	})
 	if err != nil {
 		if errors.IsConflictError(err) {
 			return diag.Errorf("Role (%s) already exists, use a different name to create role or use terraform import to bring current role under terraform management", createRequest.Name)
@@ -309,7 +314,9 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		DefaultApp:                patchRequest.DefaultApp,
 		ImportedRoles:             patchRequest.ImportedRoles,
 	}
-	err := WaitRoleUpdate(ctx, acsClient, stack, patchParam, patchRequestBody, roleName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitRoleUpdate(ctx, acsClient, stack, patchParam, patchRequestBody, roleName)
+	})
 	if err != nil {
 		return diag.Errorf("Error submitting request for role (%s) to be updated: %s", roleName, err)
 	}
@@ -332,7 +339,9 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	roleName := d.Id()
 
-	err := WaitRoleDelete(ctx, acsClient, stack, roleName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitRoleDelete(ctx, acsClient, stack, roleName)
+	})
 	if err != nil {
 		return diag.Errorf("%s", fmt.Sprintf("Error deleting role (%s): %s", roleName, err))
 	}
@@ -341,6 +350,63 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 
+// customizeRoleDiff rejects added capabilities that can not be granted at plan time, instead of failing at apply time
+func customizeRoleDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(schemaKeyCapabilities) || !d.HasChange(schemaKeyCapabilities) || m == nil {
+		return nil
+	}
+
+	// Only added capabilities are validated, so that roles imported with capabilities that are not grantable can still be updated
+	rawOld, rawNew := d.GetChange(schemaKeyCapabilities)
+	capabilities := utils.ParseSetValues(rawNew.(*schema.Set).Difference(rawOld.(*schema.Set)))
+	if len(capabilities) == 0 {
+		return nil
+	}
+
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	capabilitiesInfo, err := WaitCapabilitiesList(ctx, acsClient, stack, true)
+	if err != nil {
+		return fmt.Errorf("error reading grantable capabilities to validate role capabilities: %s", err)
+	}
+
+	var grantable []string
+	if capabilitiesInfo.GrantableCapabilities != nil {
+		grantable = *capabilitiesInfo.GrantableCapabilities
+	}
+	return ValidateCapabilities(capabilities, grantable)
+}
+
+// ValidateCapabilities checks that every capability is grantable, suggesting the closest grantable capability for typos
+func ValidateCapabilities(capabilities []string, grantable []string) error {
+	grantableSet := make(map[string]bool, len(grantable))
+	for _, capability := range grantable {
+		grantableSet[capability] = true
+	}
+
+	sorted := append([]string{}, capabilities...)
+	sort.Strings(sorted)
+
+	var errs []string
+	for _, capability := range sorted {
+		if grantableSet[capability] {
+			continue
+		}
+		if suggestion, ok := utils.ClosestMatch(capability, grantable); ok {
+			errs = append(errs, fmt.Sprintf("capability (%s) is not grantable, did you mean (%s)?", capability, suggestion))
+		} else {
+			errs = append(errs, fmt.Sprintf("capability (%s) is not grantable", capability))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid role capabilities: %s. Use the scp_capabilities data source to list the grantable capabilities", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 func parseRoleRequest(d *schema.ResourceData) (*v2.RolesRequest, string) {
 	rolesRequest := v2.RolesRequest{}
 	rolesInfo := v2.RolesInfo{}
@@ -13,6 +13,8 @@ import (
 	"github.com/splunk/terraform-provider-scp/client"
 	"github.com/splunk/terraform-provider-scp/internal/acctest"
 	"github.com/splunk/terraform-provider-scp/internal/indexes"
+	"github.com/splunk/terraform-provider-scp/internal/roles"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -168,3 +170,16 @@ func testAccCheckRoleDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func Test_ValidateCapabilities(t *testing.T) {
+	grantable := []string{"search", "schedule_search", "edit_token_http", "list_inputs"}
+
+	assert.NoError(t, roles.ValidateCapabilities([]string{"search", "list_inputs"}, grantable))
+
+	err := roles.ValidateCapabilities([]string{"schedule_serach"}, grantable)
+	assert.ErrorContains(t, err, "did you mean (schedule_search)?")
+
+	err = roles.ValidateCapabilities([]string{"admin_all_objects", "search"}, grantable)
+	assert.ErrorContains(t, err, "capability (admin_all_objects) is not grantable")
+	assert.NotContains(t, err.Error(), "did you mean")
+}
@@ -0,0 +1,89 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyList = "scp_roles_list"
+
+	schemaKeyNames = "names"
+	schemaKeyRoles = "roles"
+)
+
+func rolesListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyNames: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sorted names of the roles of the stack.",
+		},
+		schemaKeyRoles: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: roleAttributesSchema(),
+			},
+			Description: "The roles of the stack sorted by name.",
+		},
+	}
+}
+
+func DataSourceRolesList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Roles List Data Source. Use this data source to list every role of the stack with its effective " +
+			"capabilities and imported roles, for example to review access on a stack without managing every role with Terraform. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageRoles " +
+			"for more latest, detailed information on attribute requirements and the ACS Roles API.",
+
+		ReadContext: dataSourceRolesListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: rolesListDataSourceSchema(),
+	}
+}
+
+func dataSourceRolesListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	roles, err := WaitRoleList(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error listing roles: %s", err)
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	names := make([]string, 0, len(roles))
+	flattened := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+		flattened = append(flattened, FlattenRole(role))
+	}
+
+	if err := d.Set(schemaKeyNames, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRoles, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/roles", stack))
+
+	return nil
+}
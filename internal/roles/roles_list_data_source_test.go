@@ -0,0 +1,28 @@
+package roles_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const rolesListDataSourceTemplate = `
+data "scp_roles_list" "test" {}
+`
+
+func TestAcc_SplunkCloudRolesList_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: rolesListDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_roles_list.test", "names.#"),
+					resource.TestCheckResourceAttrSet("data.scp_roles_list.test", "roles.0.effective_capabilities.#"),
+				),
+			},
+		},
+	})
+}
@@ -153,3 +153,68 @@ func VerifyRoleUpdate(patchRequest v2.PatchRoleInfoJSONRequestBody, roleResponse
 	}
 	return true
 }
+
+// CapabilitiesStatusList returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the capabilities of the stack
+func CapabilitiesStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, grantableOnly bool) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		grantableOnlyParam := v2.GrantableOnly(grantableOnly)
+		resp, err := acsClient.ListCapabilities(ctx, stack, &v2.ListCapabilitiesParams{GrantableOnly: &grantableOnlyParam})
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var capabilities v2.CapabilitiesInfo
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &capabilities); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &capabilities, status, nil
+	}
+}
+
+// RoleStatusList returns StateRefreshFunc that makes GET request for a page of roles, checks if request was successful, and returns the roles of the page
+func RoleStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListRolesParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListRoles(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		roles := make([]v2.RolesResponse, 0)
+		if resp.StatusCode == http.StatusOK {
+			var body struct {
+				Roles *[]v2.RolesResponse `json:"roles,omitempty"`
+			}
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.Roles != nil {
+				roles = *body.Roles
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return roles, status, nil
+	}
+}
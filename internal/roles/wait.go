@@ -17,6 +17,11 @@ var (
 	TargetStatusResourceDeleted = []string{http.StatusText(200)}
 )
 
+const (
+	// RolePageSize is the number of roles requested per ListRoles call
+	RolePageSize = 100
+)
+
 // WaitRoleCreate Handles retry logic for POST requests for create lifecycle function
 func WaitRoleCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createParams v2.CreateRoleParams, createRoleRequest v2.CreateRoleJSONRequestBody) error {
 	waitRoleCreateAccepted := wait.GenerateWriteStateChangeConf(RoleStatusCreate(ctx, acsClient, stack, createParams, createRoleRequest))
@@ -102,3 +107,41 @@ func WaitRoleDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.
 	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for role (%s): %s\n", roleName, resp.Header.Get("X-REQUEST-ID")))
 	return nil
 }
+
+// WaitCapabilitiesList Handles retry logic for GET requests listing the capabilities of the stack
+func WaitCapabilitiesList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, grantableOnly bool) (*v2.CapabilitiesInfo, error) {
+	waitCapabilitiesList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, CapabilitiesStatusList(ctx, acsClient, stack, grantableOnly))
+
+	output, err := waitCapabilitiesList.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error listing capabilities: %s", err))
+		return nil, err
+	}
+	capabilities := output.(*v2.CapabilitiesInfo)
+
+	return capabilities, nil
+}
+
+// WaitRoleList Handles retry logic for GET requests listing roles, pages through every role of the stack
+func WaitRoleList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.RolesResponse, error) {
+	count := v2.Count(RolePageSize)
+
+	roles := make([]v2.RolesResponse, 0)
+	for offset := v2.Offset(0); ; offset += RolePageSize {
+		pageOffset := offset
+		params := v2.ListRolesParams{Count: &count, Offset: &pageOffset}
+		waitRoleList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, RoleStatusList(ctx, acsClient, stack, params))
+
+		output, err := waitRoleList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing roles at offset (%d): %s", offset, err))
+			return nil, err
+		}
+		page := output.([]v2.RolesResponse)
+		roles = append(roles, page...)
+
+		if len(page) < RolePageSize {
+			return roles, nil
+		}
+	}
+}
@@ -260,3 +260,64 @@ func Test_WaitRoleDelete(t *testing.T) {
 		}
 	})
 }
+
+func Test_WaitCapabilitiesList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+	grantable := []string{"search", "schedule_search"}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		body, _ := json.Marshal(v2.CapabilitiesInfo{GrantableCapabilities: &grantable})
+		client.On("ListCapabilities", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListCapabilitiesParams) bool {
+			return params != nil && params.GrantableOnly != nil && bool(*params.GrantableOnly)
+		})).Return(generateResponse(http.StatusTooManyRequests), nil).Once()
+		client.On("ListCapabilities", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(generateBodyResponse(http.StatusOK, body), nil).Once()
+		capabilities, err := roles.WaitCapabilitiesList(context.TODO(), client, mockStack, true)
+		assert.NoError(t, err)
+		assert.Equal(t, grantable, *capabilities.GrantableCapabilities)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("ListCapabilities", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(generateResponse(http.StatusForbidden), nil).Once()
+		capabilities, err := roles.WaitCapabilitiesList(context.TODO(), client, mockStack, false)
+		assert.Error(t, err)
+		assert.Nil(t, capabilities)
+	})
+}
+
+func generateBodyResponse(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
+
+func Test_WaitRoleList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		fullPage, _ := json.Marshal(map[string][]v2.RolesResponse{"roles": make([]v2.RolesResponse, roles.RolePageSize)})
+		lastPage, _ := json.Marshal(map[string][]v2.RolesResponse{"roles": {{Name: mockRoleName}}})
+		client.On("ListRoles", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListRolesParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(generateBodyResponse(http.StatusOK, fullPage), nil).Once()
+		client.On("ListRoles", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListRolesParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == roles.RolePageSize
+		})).Return(generateBodyResponse(http.StatusOK, lastPage), nil).Once()
+		roleList, err := roles.WaitRoleList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, roleList, roles.RolePageSize+1)
+		assert.Equal(t, mockRoleName, roleList[roles.RolePageSize].Name)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("ListRoles", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(generateResponse(code), nil).Once()
+				roleList, err := roles.WaitRoleList(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, roleList)
+			})
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package selfstorage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyPolicy = "scp_self_storage_location_policy"
+
+	schemaKeyPolicy = "policy"
+)
+
+func policyDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyBucketName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The name of the bucket to generate the access policy for.",
+		},
+		schemaKeyPolicy: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON encoded bucket policy that grants the stack access to the bucket.",
+		},
+	}
+}
+
+func DataSourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Policy Data Source. Use this data source to retrieve the bucket policy that must be " +
+			"applied to a self storage location bucket on AWS stacks. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on attribute requirements and the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourcePolicyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: policyDataSourceSchema(),
+	}
+}
+
+func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	bucketName := d.Get(schemaKeyBucketName).(string)
+
+	policy, err := WaitPolicyRead(ctx, acsClient, stack, bucketName)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location policy for bucket (%s): %s", bucketName, err)
+	}
+
+	policyJSON, err := json.Marshal(policy.Policy)
+	if err != nil {
+		return diag.Errorf("Error encoding self storage location policy for bucket (%s): %s", bucketName, err)
+	}
+
+	if err := d.Set(schemaKeyPolicy, string(policyJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(bucketName)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package selfstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyPrefix = "scp_self_storage_location_prefix"
+
+	schemaKeyPrefix = "prefix"
+)
+
+func prefixDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyPrefix: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The prefix that the name of every self storage location bucket for the stack must begin with.",
+		},
+	}
+}
+
+func DataSourcePrefix() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Prefix Data Source. Use this data source to retrieve the bucket name prefix required " +
+			"when creating the bucket for a self storage location. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on attribute requirements and the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourcePrefixRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: prefixDataSourceSchema(),
+	}
+}
+
+func dataSourcePrefixRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	prefix, err := WaitPrefixRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location prefix: %s", err)
+	}
+
+	if err := d.Set(schemaKeyPrefix, prefix.Prefix); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
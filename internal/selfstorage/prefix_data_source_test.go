@@ -0,0 +1,25 @@
+package selfstorage_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const prefixDataSourceTemplate = `
+data "scp_self_storage_location_prefix" "test" {}
+`
+
+func TestAcc_SplunkCloudSelfStorageLocationPrefix_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: prefixDataSourceTemplate,
+				Check:  resource.TestCheckResourceAttrSet("data.scp_self_storage_location_prefix.test", "prefix"),
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package selfstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	ResourceKey = "scp_self_storage_location"
+
+	schemaKeyBucketName  = "bucket_name"
+	schemaKeyFolder      = "folder"
+	schemaKeyTitle       = "title"
+	schemaKeyDescription = "description"
+	schemaKeyURI         = "uri"
+	schemaKeyBucketPath  = "bucket_path"
+)
+
+func selfStorageLocationResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyBucketName: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description: "The name of the existing bucket in the cloud provider account. The bucket name must begin with the prefix " +
+				"returned by the `scp_self_storage_location_prefix` data source. Can not be updated after creation.",
+		},
+		schemaKeyFolder: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The name of the folder within the bucket to use as the self storage location. Can not be updated after creation.",
+		},
+		schemaKeyTitle: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The title of the self storage location. Can not be updated after creation.",
+		},
+		schemaKeyDescription: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The description of the self storage location. Can not be updated after creation.",
+		},
+		schemaKeyURI: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URI of the self storage location.",
+		},
+		schemaKeyBucketPath: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The bucket path of the self storage location. Use this value for the `self_storage_bucket_path` " +
+				"attribute of an `scp_indexes` resource.",
+		},
+	}
+}
+
+func ResourceSelfStorageLocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations " +
+			"for more latest, detailed information on attribute requirements and the ACS Self Storage Locations API.",
+
+		CreateContext: resourceSelfStorageLocationCreate,
+		ReadContext:   resourceSelfStorageLocationRead,
+		DeleteContext: resourceSelfStorageLocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: selfStorageLocationResourceSchema(),
+	}
+}
+
+func resourceSelfStorageLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	// Retrieve data for each field and create request body
+	createLocationRequest := parseSelfStorageLocationRequest(d)
+	bucketName := createLocationRequest.BucketName
+
+	var location *v2.SelfStorageLocationInfo
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() (err error) {
+		location, err = WaitLocationCreate(ctx, acsClient, stack, *createLocationRequest)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for self storage location (%s) to be created: %s", bucketName, err)
+	}
+	if location.BucketPath == "" {
+		return diag.Errorf("Error creating self storage location (%s): ACS did not return a bucket path", bucketName)
+	}
+
+	// Poll location until GET returns 200 to confirm creation, so that dependent indexes can reference it in the same apply
+	err = WaitLocationPoll(ctx, acsClient, stack, location.BucketPath, wait.TargetStatusResourceExists, PendingStatusVerifyCreated)
+	if err != nil {
+		return diag.Errorf("Error waiting for self storage location (%s) to be created: %s", bucketName, err)
+	}
+
+	// Set ID of location resource to the bucket path to indicate location has been created
+	d.SetId(location.BucketPath)
+	tflog.Info(ctx, fmt.Sprintf("Created self storage location resource: %s\n", location.BucketPath))
+
+	// Call read to set attributes of location
+	return resourceSelfStorageLocationRead(ctx, d, m)
+}
+
+func resourceSelfStorageLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	bucketPath := d.Id()
+
+	location, err := WaitLocationRead(ctx, acsClient, stack, bucketPath)
+	if err != nil {
+		// if location not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing self storage location from state. Not Found error while reading self storage location (%s): %s.", bucketPath, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading self storage location (%s): %s", bucketPath, err)
+	}
+
+	if err := d.Set(schemaKeyBucketName, location.BucketName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyFolder, location.Folder); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyTitle, location.Title); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyDescription, location.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyURI, location.Uri); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyBucketPath, location.BucketPath); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSelfStorageLocationDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// ACS does not support deleting self storage locations, the location is only removed from terraform state
+	tflog.Warn(ctx, fmt.Sprintf("Self storage location (%s) removed from state only. ACS does not support deleting self storage locations, "+
+		"please contact Splunk support to remove it from the stack.", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func parseSelfStorageLocationRequest(d *schema.ResourceData) *v2.CreateSelfStorageLocationJSONRequestBody {
+	createLocationRequest := v2.CreateSelfStorageLocationJSONRequestBody{
+		BucketName: d.Get(schemaKeyBucketName).(string),
+		Title:      d.Get(schemaKeyTitle).(string),
+	}
+
+	if folder, ok := d.GetOk(schemaKeyFolder); ok {
+		folderVal := folder.(string)
+		createLocationRequest.Folder = &folderVal
+	}
+
+	if description, ok := d.GetOk(schemaKeyDescription); ok {
+		descriptionVal := description.(string)
+		createLocationRequest.Description = &descriptionVal
+	}
+
+	return &createLocationRequest
+}
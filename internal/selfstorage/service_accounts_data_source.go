@@ -0,0 +1,78 @@
+package selfstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyServiceAccounts = "scp_self_storage_location_service_accounts"
+
+	schemaKeyIndexer       = "indexer"
+	schemaKeyClusterMaster = "cluster_master"
+)
+
+func serviceAccountsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyIndexer: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service account used by the stack indexers. Grant it access to the self storage location bucket.",
+		},
+		schemaKeyClusterMaster: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service account used by the stack cluster master. Grant it access to the self storage location bucket.",
+		},
+	}
+}
+
+func DataSourceServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Self Storage Location Service Accounts Data Source. Use this data source to retrieve the service accounts " +
+			"that must be granted access to a self storage location bucket on Google Cloud stacks. Please refer to " +
+			"https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageDDSSlocations for more latest, detailed " +
+			"information on attribute requirements and the ACS Self Storage Locations API.",
+
+		ReadContext: dataSourceServiceAccountsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: serviceAccountsDataSourceSchema(),
+	}
+}
+
+func dataSourceServiceAccountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	resp, err := WaitServiceAccountsRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading self storage location service accounts: %s", err)
+	}
+
+	var indexer, clusterMaster string
+	if resp.ServiceAccounts != nil {
+		indexer = resp.ServiceAccounts.Indexer
+		clusterMaster = resp.ServiceAccounts.ClusterMaster
+	}
+
+	if err := d.Set(schemaKeyIndexer, indexer); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyClusterMaster, clusterMaster); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
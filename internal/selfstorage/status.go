@@ -0,0 +1,149 @@
+package selfstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// LocationStatusCreate returns StateRefreshFunc that makes POST request, checks if response is accepted and returns the created location
+func LocationStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createLocationRequest v2.CreateSelfStorageLocationJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.CreateSelfStorageLocation(ctx, stack, createLocationRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+		_, statusText, statusErr := status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+		if statusErr != nil {
+			return nil, statusText, statusErr
+		}
+
+		var location v2.SelfStorageLocationInfo
+		if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &location); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return &location, statusText, nil
+	}
+}
+
+// LocationStatusPoll returns StateRefreshFunc that makes GET request and checks if response is desired target (200 for create)
+func LocationStatusPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string, targetStatus []string, pendingStatus []string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeSelfStorageLocation(ctx, stack, v2.BucketPath(bucketPath))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, targetStatus, pendingStatus)
+	}
+}
+
+// LocationStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns location response
+func LocationStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeSelfStorageLocation(ctx, stack, v2.BucketPath(bucketPath))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var location v2.SelfStorageLocationInfo
+		if resp.StatusCode == http.StatusOK {
+			if err = json.Unmarshal(bodyBytes, &location); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return &location, status, nil
+	}
+}
+
+// PrefixStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the bucket name prefix response
+func PrefixStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationPrefix(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var prefix v2.SelfStorageLocationPrefix
+		return processReadResponse(resp, &prefix)
+	}
+}
+
+// ServiceAccountsStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the service accounts response
+func ServiceAccountsStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationServiceAccounts(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var serviceAccounts v2.SelfStorageLocationServiceAccountsResponse
+		return processReadResponse(resp, &serviceAccounts)
+	}
+}
+
+// PolicyStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the bucket policy response
+func PolicyStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetSelfStorageLocationPolicy(ctx, stack, v2.BucketName(bucketName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		var policy v2.SelfStorageLocationPolicy
+		return processReadResponse(resp, &policy)
+	}
+}
+
+// processReadResponse unmarshals a successful GET response into output, treating GeneralRetryableStatusCodes as pending
+func processReadResponse(resp *http.Response, output any) (any, string, error) {
+	bodyBytes, _ := io.ReadAll(resp.Body)
+
+	if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+		return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+			State:         http.StatusText(resp.StatusCode),
+			ExpectedState: wait.TargetStatusResourceExists,
+			LastError:     errors.New(string(bodyBytes)),
+		}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(bodyBytes, output); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+	}
+	return output, http.StatusText(resp.StatusCode), nil
+}
@@ -0,0 +1,197 @@
+package selfstorage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var successRespOk = &http.Response{
+	StatusCode: http.StatusOK,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var notFoundResp = &http.Response{
+	StatusCode: http.StatusNotFound,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+var rateLimitResp = &http.Response{
+	StatusCode: http.StatusTooManyRequests,
+	Body:       io.NopCloser(bytes.NewReader(nil)),
+}
+
+func Test_LocationStatusCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(http.StatusAccepted), nil).Once()
+		output, statusText, err := selfstorage.LocationStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+		location := output.(*v2.SelfStorageLocationInfo)
+		assert.Equal(t, mockBucketPath, location.BucketPath)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(nil, errors.New("some error")).Once()
+		output, _, err := selfstorage.LocationStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_LocationStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(http.StatusOK), nil).Once()
+		output, statusText, err := selfstorage.LocationStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		location := output.(*v2.SelfStorageLocationInfo)
+		assert.Equal(t, mockBucketName, location.BucketName)
+		assert.Equal(t, mockTitle, location.Title)
+		assert.Equal(t, mockURI, location.Uri)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(http.StatusTooManyRequests), nil).Once()
+		_, statusText, err := selfstorage.LocationStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(http.StatusNotFound), nil).Once()
+		output, statusText, err := selfstorage.LocationStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(nil, errors.New("some error")).Once()
+		output, _, err := selfstorage.LocationStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_PrefixStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPrefix", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, v2.SelfStorageLocationPrefix{Prefix: mockPrefix}), nil).Once()
+		output, statusText, err := selfstorage.PrefixStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockPrefix, output.(*v2.SelfStorageLocationPrefix).Prefix)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPrefix", mock.Anything, v2.Stack(mockStack)).Return(rateLimitResp, nil).Once()
+		_, statusText, err := selfstorage.PrefixStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPrefix", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		output, _, err := selfstorage.PrefixStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_ServiceAccountsStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		serviceAccounts := v2.SelfStorageLocationServiceAccountsResponse{
+			ServiceAccounts: &v2.SelfStorageLocationServiceAccounts{Indexer: mockIndexerAccount, ClusterMaster: mockClusterMasterAccount},
+		}
+		client.On("GetSelfStorageLocationServiceAccounts", mock.Anything, v2.Stack(mockStack)).Return(genJSONResp(http.StatusOK, serviceAccounts), nil).Once()
+		output, statusText, err := selfstorage.ServiceAccountsStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		resp := output.(*v2.SelfStorageLocationServiceAccountsResponse)
+		assert.Equal(t, mockIndexerAccount, resp.ServiceAccounts.Indexer)
+		assert.Equal(t, mockClusterMasterAccount, resp.ServiceAccounts.ClusterMaster)
+	})
+
+	t.Run("with unexpected response 400", func(t *testing.T) {
+		client.On("GetSelfStorageLocationServiceAccounts", mock.Anything, v2.Stack(mockStack)).Return(genLocationResp(http.StatusBadRequest), nil).Once()
+		output, statusText, err := selfstorage.ServiceAccountsStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), statusText)
+	})
+}
+
+func Test_PolicyStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		policy := v2.SelfStorageLocationPolicy{Policy: map[string]interface{}{"Version": "2012-10-17"}}
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genJSONResp(http.StatusOK, policy), nil).Once()
+		output, statusText, err := selfstorage.PolicyStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketName)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, "2012-10-17", output.(*v2.SelfStorageLocationPolicy).Policy["Version"])
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genLocationResp(http.StatusNotFound), nil).Once()
+		output, statusText, err := selfstorage.PolicyStatusRead(context.TODO(), client, v2.Stack(mockStack), mockBucketName)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+}
+
+func genJSONResp(code int, body any) *http.Response {
+	b, _ := json.Marshal(body)
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
+
+func genLocationResp(code int) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(&v2.SelfStorageLocationInfo{
+			BucketName:  mockBucketName,
+			BucketPath:  mockBucketPath,
+			Description: mockDescription,
+			Folder:      mockFolder,
+			Title:       mockTitle,
+			Uri:         mockURI,
+		})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
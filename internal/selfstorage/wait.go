@@ -0,0 +1,94 @@
+package selfstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+var (
+	// PendingStatusVerifyCreated treats 404 as pending since a newly created location is not immediately visible
+	PendingStatusVerifyCreated = []string{http.StatusText(http.StatusNotFound), http.StatusText(http.StatusFailedDependency), http.StatusText(http.StatusTooManyRequests)}
+)
+
+// WaitLocationCreate Handles retry logic for POST requests for create lifecycle function
+func WaitLocationCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createLocationRequest v2.CreateSelfStorageLocationJSONRequestBody) (*v2.SelfStorageLocationInfo, error) {
+	waitLocationCreateAccepted := wait.GenerateWriteStateChangeConf(LocationStatusCreate(ctx, acsClient, stack, createLocationRequest))
+
+	output, err := waitLocationCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for self storage location (%s) to be created: %s", createLocationRequest.BucketName, err))
+		return nil, err
+	}
+
+	location := output.(*v2.SelfStorageLocationInfo)
+
+	tflog.Info(ctx, fmt.Sprintf("Create request accepted for self storage location (%s), bucket path: %s\n", createLocationRequest.BucketName, location.BucketPath))
+
+	return location, nil
+}
+
+// WaitLocationPoll Handles retry logic for polling after POST requests for create lifecycle function
+func WaitLocationPoll(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string, targetStatus []string, pendingStatus []string) error {
+	waitLocationState := wait.GenerateReadStateChangeConf(pendingStatus, targetStatus, LocationStatusPoll(ctx, acsClient, stack, bucketPath, targetStatus, pendingStatus))
+
+	_, err := waitLocationState.WaitForStateContext(ctx)
+	return err
+}
+
+// WaitLocationRead Handles retry logic for GET requests for the read lifecycle function
+func WaitLocationRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketPath string) (*v2.SelfStorageLocationInfo, error) {
+	waitLocationRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, LocationStatusRead(ctx, acsClient, stack, bucketPath))
+
+	output, err := waitLocationRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location (%s): %s", bucketPath, err))
+		return nil, err
+	}
+	location := output.(*v2.SelfStorageLocationInfo)
+
+	return location, nil
+}
+
+// WaitPrefixRead Handles retry logic for GET requests for the bucket name prefix data source
+func WaitPrefixRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.SelfStorageLocationPrefix, error) {
+	waitPrefixRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PrefixStatusRead(ctx, acsClient, stack))
+
+	output, err := waitPrefixRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location prefix: %s", err))
+		return nil, err
+	}
+
+	return output.(*v2.SelfStorageLocationPrefix), nil
+}
+
+// WaitServiceAccountsRead Handles retry logic for GET requests for the service accounts data source
+func WaitServiceAccountsRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.SelfStorageLocationServiceAccountsResponse, error) {
+	waitServiceAccountsRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, ServiceAccountsStatusRead(ctx, acsClient, stack))
+
+	output, err := waitServiceAccountsRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location service accounts: %s", err))
+		return nil, err
+	}
+
+	return output.(*v2.SelfStorageLocationServiceAccountsResponse), nil
+}
+
+// WaitPolicyRead Handles retry logic for GET requests for the bucket policy data source
+func WaitPolicyRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, bucketName string) (*v2.SelfStorageLocationPolicy, error) {
+	waitPolicyRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, PolicyStatusRead(ctx, acsClient, stack, bucketName))
+
+	output, err := waitPolicyRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading self storage location policy for bucket (%s): %s", bucketName, err))
+		return nil, err
+	}
+
+	return output.(*v2.SelfStorageLocationPolicy), nil
+}
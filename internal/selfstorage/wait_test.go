@@ -0,0 +1,155 @@
+package selfstorage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/selfstorage"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack       = "mock-stack"
+	mockBucketName  = "mock-prefix-bucket"
+	mockFolder      = "mock-folder"
+	mockTitle       = "mock-title"
+	mockDescription = "mock-description"
+	mockBucketPath  = "mock-prefix-bucket/mock-folder"
+	mockURI         = "s3://mock-prefix-bucket/mock-folder"
+	mockPrefix      = "mock-prefix"
+
+	mockIndexerAccount       = "indexer@mock-project.iam.gserviceaccount.com"
+	mockClusterMasterAccount = "cluster-master@mock-project.iam.gserviceaccount.com"
+)
+
+var (
+	mockCreateBody = v2.CreateSelfStorageLocationJSONRequestBody{
+		BucketName: mockBucketName,
+		Title:      mockTitle,
+	}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitLocationCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(nil, errors.New("some error")).Once()
+		_, err := selfstorage.WaitLocationCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 202", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(202), nil).Once()
+		location, err := selfstorage.WaitLocationCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)
+		assert.NoError(t, err)
+		assert.Equal(t, mockBucketPath, location.BucketPath)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(rateLimitResp, nil).Once()
+		client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(202), nil).Once()
+		location, err := selfstorage.WaitLocationCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)
+		assert.NoError(t, err)
+		assert.Equal(t, mockBucketPath, location.BucketPath)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("CreateSelfStorageLocation", mock.Anything, v2.Stack(mockStack), mockCreateBody).Return(genLocationResp(statusCode), nil).Once()
+				_, err := selfstorage.WaitLocationCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateBody)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLocationPoll(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(nil, errors.New("some error")).Once()
+		err := selfstorage.WaitLocationPoll(context.TODO(), client, v2.Stack(mockStack), mockBucketPath, wait.TargetStatusResourceExists, selfstorage.PendingStatusVerifyCreated)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(successRespOk, nil).Once()
+		err := selfstorage.WaitLocationPoll(context.TODO(), client, v2.Stack(mockStack), mockBucketPath, wait.TargetStatusResourceExists, selfstorage.PendingStatusVerifyCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with pending response 404 then 200", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(notFoundResp, nil).Once()
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(200), nil).Once()
+		err := selfstorage.WaitLocationPoll(context.TODO(), client, v2.Stack(mockStack), mockBucketPath, wait.TargetStatusResourceExists, selfstorage.PendingStatusVerifyCreated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range []int{400, 401, 403, 500, 503} {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(statusCode), nil).Once()
+				err := selfstorage.WaitLocationPoll(context.TODO(), client, v2.Stack(mockStack), mockBucketPath, wait.TargetStatusResourceExists, selfstorage.PendingStatusVerifyCreated)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitLocationRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(nil, errors.New("some error")).Once()
+		_, err := selfstorage.WaitLocationRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)
+		assert.Error(t, err)
+	})
+
+	t.Run("with http response 200", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(200), nil).Once()
+		location, err := selfstorage.WaitLocationRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)
+		assert.NoError(t, err)
+		assert.Equal(t, mockBucketName, location.BucketName)
+	})
+
+	t.Run("with not found response", func(t *testing.T) {
+		client.On("DescribeSelfStorageLocation", mock.Anything, v2.Stack(mockStack), v2.BucketPath(mockBucketPath)).Return(genLocationResp(404), nil).Once()
+		_, err := selfstorage.WaitLocationRead(context.TODO(), client, v2.Stack(mockStack), mockBucketPath)
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitPolicyRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		policy := v2.SelfStorageLocationPolicy{Policy: map[string]interface{}{"Version": "2012-10-17"}}
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(rateLimitResp, nil).Once()
+		client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genJSONResp(200, policy), nil).Once()
+		output, err := selfstorage.WaitPolicyRead(context.TODO(), client, v2.Stack(mockStack), mockBucketName)
+		assert.NoError(t, err)
+		assert.Equal(t, "2012-10-17", output.Policy["Version"])
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetSelfStorageLocationPolicy", mock.Anything, v2.Stack(mockStack), v2.BucketName(mockBucketName)).Return(genLocationResp(statusCode), nil).Once()
+				_, err := selfstorage.WaitPolicyRead(context.TODO(), client, v2.Stack(mockStack), mockBucketName)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/locks"
 	"github.com/splunk/terraform-provider-scp/internal/wait"
@@ -101,13 +102,20 @@ func resourceSplunkbaseAppCreate(ctx context.Context, resourceData *schema.Resou
 	}
 
 	data.Set("splunkbaseID", splunkbaseIDParam.(string))
-	body := strings.NewReader(data.Encode())
 	err := resource.RetryContext(ctx, RetryTimeout, func() *resource.RetryError {
+		body := strings.NewReader(data.Encode())
 		err := WaitAppCreate(ctx, acsClient, stack, installParams, body)
 		if err != nil {
 			if errors.IsConflictError(err.Err) {
 				return resource.NonRetryableError(err.Err)
 			}
+			if errors.IsFailedDeploymentTaskError(err.Err) {
+				// retry the failed deployment task, the create request is resubmitted by the next attempt
+				if retryErr := deployments.WaitRetryTask(ctx, acsClient, stack); retryErr != nil {
+					return resource.NonRetryableError(retryErr)
+				}
+				return resource.RetryableError(err.Err)
+			}
 			if strings.Contains(err.Err.Error(), "503") {
 				return resource.RetryableError(fmt.Errorf("received 503 error, retrying: %v", err.Err))
 			}
@@ -199,7 +207,9 @@ func resourceSplunkbaseAppDelete(ctx context.Context, resourceData *schema.Resou
 	appName := resourceData.Id()
 
 	retryErr := resource.RetryContext(ctx, 2*RetryTimeout, func() *resource.RetryError {
-		err := WaitAppDelete(ctx, acsClient, stack, appName)
+		err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitAppDelete(ctx, acsClient, stack, appName)
+		})
 		if err != nil {
 			if strings.Contains(err.Error(), "503") {
 				return resource.RetryableError(fmt.Errorf("received 503 error, retrying: %w", err))
@@ -253,10 +263,10 @@ func resourceSplunkbaseAppUpdate(ctx context.Context, resourceData *schema.Resou
 	data.Set("version", resourceData.Get("version").(string))
 	data.Set("splunkbaseID", resourceData.Get("splunkbase_id").(string))
 
-	body := strings.NewReader(data.Encode())
-
 	retryErr := resource.RetryContext(ctx, RetryTimeout, func() *resource.RetryError {
-		err := WaitAppUpdate(ctx, acsClient, stack, appName, installParams, body)
+		err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+			return WaitAppUpdate(ctx, acsClient, stack, appName, installParams, strings.NewReader(data.Encode()))
+		})
 		if err != nil {
 			if strings.Contains(err.Error(), "503") {
 				return resource.RetryableError(fmt.Errorf("received 503 error, retrying: %w", err))
@@ -0,0 +1,93 @@
+package stacks
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKey = "scp_stack"
+
+	schemaKeyStackType       = "stack_type"
+	schemaKeyStackVersion    = "stack_version"
+	schemaKeyStatus          = "status"
+	schemaKeyRestartRequired = "restart_required"
+)
+
+func stackDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyStackType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the stack, either `Victoria` or `Classic`.",
+		},
+		schemaKeyStackVersion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Splunk Cloud Platform version of the stack.",
+		},
+		schemaKeyStatus: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The status of the stack infrastructure. `Ready` if the infrastructure is up to date, `Pending` if " +
+				"changes have not been applied yet and `Failed` if applying changes failed.",
+		},
+		schemaKeyRestartRequired: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the stack has a notification to restart the Splunk server for configuration changes to take effect.",
+		},
+	}
+}
+
+func DataSourceStack() *schema.Resource {
+	return &schema.Resource{
+		Description: "Stack Data Source. Use this data source to retrieve the type, version and status of the stack, for example " +
+			"to branch on Victoria and Classic stacks or to refuse to apply when the infrastructure has failed. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ACSIntro " +
+			"for more latest, detailed information on attribute requirements and the ACS Stack API.",
+
+		ReadContext: dataSourceStackRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: stackDataSourceSchema(),
+	}
+}
+
+func dataSourceStackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	stackStatus, err := WaitStackRead(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error reading stack (%s) status: %s", stack, err)
+	}
+
+	if err := d.Set(schemaKeyStackType, stackStatus.Infrastructure.StackType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStackVersion, stackStatus.Infrastructure.StackVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStatus, stackStatus.Infrastructure.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRestartRequired, stackStatus.Messages.RestartRequired); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(stack))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package stacks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const stackDataSourceTemplate = `
+data "scp_stack" "test" {}
+`
+
+func TestAcc_SplunkCloudStack_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: stackDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "stack_type"),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "stack_version"),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "status"),
+					resource.TestCheckResourceAttrSet("data.scp_stack.test", "restart_required"),
+				),
+			},
+		},
+	})
+}
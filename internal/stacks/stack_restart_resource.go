@@ -0,0 +1,135 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+)
+
+const (
+	ResourceKeyRestart = "scp_stack_restart"
+
+	schemaKeyTriggers              = "triggers"
+	schemaKeyOnlyIfRestartRequired = "only_if_restart_required"
+	schemaKeyRestarted             = "restarted"
+	schemaKeyRestartedAt           = "restarted_at"
+)
+
+func stackRestartResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyTriggers: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Arbitrary map of values that, when changed, triggers a rolling restart of the stack. " +
+				"For example the versions of the apps that require a restart after installation.",
+		},
+		schemaKeyOnlyIfRestartRequired: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "If true the stack is only restarted when ACS reports that a restart is required, " +
+				"otherwise the stack is restarted every time `triggers` changes.",
+		},
+		schemaKeyRestarted: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the stack was restarted the last time the resource was triggered.",
+		},
+		schemaKeyRestartedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the last restart triggered by the resource completed. Empty if the stack was not restarted.",
+		},
+	}
+}
+
+func ResourceStackRestart() *schema.Resource {
+	return &schema.Resource{
+		Description: "Stack Restart Resource. Triggers a rolling restart of the stack whenever `triggers` changes and waits until " +
+			"the restart has completed. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/RestartSplunkCloud " +
+			"for more latest, detailed information on attribute requirements and the ACS Restart API.",
+
+		CreateContext: resourceStackRestartCreate,
+		ReadContext:   resourceStackRestartRead,
+		UpdateContext: resourceStackRestartUpdate,
+		DeleteContext: resourceStackRestartDelete,
+
+		Schema: stackRestartResourceSchema(),
+	}
+}
+
+func resourceStackRestartCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	restarted := true
+	if d.Get(schemaKeyOnlyIfRestartRequired).(bool) {
+		stackStatus, err := WaitStackRead(ctx, acsClient, stack)
+		if err != nil {
+			return diag.Errorf("Error reading stack (%s) status: %s", stack, err)
+		}
+		restarted = stackStatus.Messages.RestartRequired != nil && *stackStatus.Messages.RestartRequired
+	}
+
+	restartedAt := ""
+	if restarted {
+		var requestID string
+		err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() (err error) {
+			requestID, err = WaitStackRestart(ctx, acsClient, stack)
+			return err
+		})
+		if err != nil {
+			return diag.Errorf("Error submitting request for stack (%s) to be restarted: %s", stack, err)
+		}
+
+		if err = WaitRestartComplete(ctx, acsClient, stack); err != nil {
+			return diag.Errorf("Error waiting for stack (%s) restart to complete, ACS Request ID (%s): %s", stack, requestID, err)
+		}
+		restartedAt = time.Now().UTC().Format(time.RFC3339)
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Stack (%s) does not require a restart, skipping restart", stack))
+	}
+
+	if err := d.Set(schemaKeyRestarted, restarted); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyRestartedAt, restartedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The restart is an action on the stack, so the stack name is used as ID
+	d.SetId(string(stack))
+	tflog.Info(ctx, fmt.Sprintf("Created stack restart resource: %s\n", stack))
+
+	return resourceStackRestartRead(ctx, d, m)
+}
+
+func resourceStackRestartRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A restart has no remote state to read, the attributes set on creation are kept
+	return nil
+}
+
+func resourceStackRestartUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only only_if_restart_required can be updated in place, it takes effect the next time triggers change
+	return resourceStackRestartRead(ctx, d, m)
+}
+
+func resourceStackRestartDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Removing the resource does not restart or otherwise change the stack
+	tflog.Info(ctx, fmt.Sprintf("Deleted stack restart resource: %s\n", d.Id()))
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,70 @@
+package stacks_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR STACK RESTART RESOURCE
+
+A rolling restart interrupts the searches of every other acceptance test running against the acceptance test stack.
+The restart flow is covered against a mocked client below.
+*/
+
+func Test_ResourceStackRestartCreate(t *testing.T) {
+	t.Run("with restart observed before completion", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(http.StatusAccepted, nil), nil).Once()
+		// the stack is idle until the restart is initiated and must not be reported as restarted before that
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, true, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Ready", false), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, stacks.ResourceStackRestart().Schema, map[string]interface{}{})
+		diags := stacks.ResourceStackRestart().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, mockStack, d.Id())
+		assert.True(t, d.Get("restarted").(bool))
+		assert.NotEmpty(t, d.Get("restarted_at"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with restart not required", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Ready", false), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, stacks.ResourceStackRestart().Schema, map[string]interface{}{"only_if_restart_required": true})
+		diags := stacks.ResourceStackRestart().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.False(t, diags.HasError(), diags)
+		assert.False(t, d.Get("restarted").(bool))
+		assert.Empty(t, d.Get("restarted_at"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with rejected restart", func(t *testing.T) {
+		client := &mocks.ClientInterface{}
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(http.StatusBadRequest, nil), nil).Once()
+
+		d := schema.TestResourceDataRaw(t, stacks.ResourceStackRestart().Schema, map[string]interface{}{})
+		diags := stacks.ResourceStackRestart().CreateContext(context.TODO(), d, genACSProvider(client))
+		assert.True(t, diags.HasError())
+		assert.Empty(t, d.Id())
+		client.AssertExpectations(t)
+	})
+}
+
+func genACSProvider(acsClient v2.ClientInterface) client.ACSProvider {
+	return client.ACSProvider{Client: &acsClient, Stack: v2.Stack(mockStack)}
+}
@@ -0,0 +1,182 @@
+package stacks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	RestartNotStartedStatus = "RESTART_NOT_STARTED"
+	RestartInProgressStatus = "RESTART_IN_PROGRESS"
+	RestartCompleteStatus   = "RESTART_COMPLETE"
+
+	InfrastructureStatusReady  = "Ready"
+	InfrastructureStatusFailed = "Failed"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// describeStackBody is the response body returned by DescribeStack
+type describeStackBody struct {
+	Status *v2.StackStatus `json:"status,omitempty"`
+}
+
+// restartStatusBody is the response body returned by RestartStatus
+type restartStatusBody struct {
+	ShcStatus *[]v2.RestartStatus `json:"shcStatus,omitempty"`
+}
+
+// StackStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the stack status
+func StackStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeStack(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		stackStatus := &v2.StackStatus{}
+		if resp.StatusCode == http.StatusOK {
+			var body describeStackBody
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.Status != nil {
+				stackStatus = body.Status
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return stackStatus, status, nil
+	}
+}
+
+// StackStatusRestart returns StateRefreshFunc that makes POST request to restart the stack and checks if request was accepted
+func StackStatusRestart(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.RestartStack(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+// RestartStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the restart status of each search head cluster member
+func RestartStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.RestartStatus(ctx, stack)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		shcStatus := make([]v2.RestartStatus, 0)
+		if resp.StatusCode == http.StatusOK {
+			var body restartStatusBody
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.ShcStatus != nil {
+				shcStatus = *body.ShcStatus
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return shcStatus, status, nil
+	}
+}
+
+// RestartStatusStarted returns StateRefreshFunc that checks the restart status and returns RestartInProgressStatus once
+// a rolling restart is observed and RestartNotStartedStatus while every search head is still idle
+func RestartStatusStarted(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		rawShcStatus, statusText, err := RestartStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawShcStatus, statusText, err
+		}
+		if IsRestartComplete(rawShcStatus.([]v2.RestartStatus)) {
+			return rawShcStatus, RestartNotStartedStatus, nil
+		}
+		return rawShcStatus, RestartInProgressStatus, nil
+	}
+}
+
+// RestartStatusComplete returns StateRefreshFunc that checks the restart status and stack status, and returns
+// RestartCompleteStatus once no rolling restart is in progress, every search head is ready and the stack is ready
+func RestartStatusComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		rawShcStatus, statusText, err := RestartStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawShcStatus, statusText, err
+		}
+		if !IsRestartComplete(rawShcStatus.([]v2.RestartStatus)) {
+			return rawShcStatus, RestartInProgressStatus, nil
+		}
+
+		rawStackStatus, statusText, err := StackStatusRead(ctx, acsClient, stack)()
+		if err != nil || statusText != http.StatusText(http.StatusOK) {
+			return rawStackStatus, statusText, err
+		}
+		stackStatus := rawStackStatus.(*v2.StackStatus)
+
+		infrastructureStatus := ""
+		if stackStatus.Infrastructure.Status != nil {
+			infrastructureStatus = *stackStatus.Infrastructure.Status
+		}
+		if strings.EqualFold(infrastructureStatus, InfrastructureStatusFailed) {
+			return nil, InfrastructureStatusFailed, &resource.UnexpectedStateError{
+				State:         InfrastructureStatusFailed,
+				ExpectedState: []string{RestartCompleteStatus},
+				LastError:     fmt.Errorf("stack infrastructure status is %s, please reach out to Splunk support for resolution", infrastructureStatus),
+			}
+		}
+		if infrastructureStatus != "" && !strings.EqualFold(infrastructureStatus, InfrastructureStatusReady) {
+			return stackStatus, RestartInProgressStatus, nil
+		}
+		return stackStatus, RestartCompleteStatus, nil
+	}
+}
+
+// IsRestartComplete returns true when no search head cluster member is in a rolling restart and every member is ready
+func IsRestartComplete(shcStatus []v2.RestartStatus) bool {
+	for _, member := range shcStatus {
+		if member.RollingRestartInitiated != nil && *member.RollingRestartInitiated {
+			return false
+		}
+		if member.ServiceReady != nil && !*member.ServiceReady {
+			return false
+		}
+	}
+	return true
+}
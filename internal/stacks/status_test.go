@@ -0,0 +1,180 @@
+package stacks_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_StackStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Ready", false), nil).Once()
+		output, statusText, err := stacks.StackStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		stackStatus := output.(*v2.StackStatus)
+		assert.Equal(t, "Ready", *stackStatus.Infrastructure.Status)
+		assert.False(t, *stackStatus.Messages.RestartRequired)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusTooManyRequests, "", false), nil).Once()
+		_, statusText, err := stacks.StackStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		output, _, err := stacks.StackStatusRead(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
+
+func Test_StackStatusRestart(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 202 response", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(http.StatusAccepted, []byte(`{"message":"restart initiated"}`)), nil).Once()
+		_, statusText, err := stacks.StackStatusRestart(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusAccepted), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		_, _, err := stacks.StackStatusRestart(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+	})
+}
+
+func Test_RestartStatusStarted(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with search heads idle", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		_, statusText, err := stacks.RestartStatusStarted(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartNotStartedStatus, statusText)
+	})
+
+	t.Run("with rolling restart initiated", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, true, true), nil).Once()
+		_, statusText, err := stacks.RestartStatusStarted(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartInProgressStatus, statusText)
+	})
+
+	t.Run("with search head not ready", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, false), nil).Once()
+		_, statusText, err := stacks.RestartStatusStarted(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartInProgressStatus, statusText)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusTooManyRequests, false, false), nil).Once()
+		_, statusText, err := stacks.RestartStatusStarted(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+}
+
+func Test_RestartStatusComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with rolling restart in progress", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, true, false), nil).Once()
+		_, statusText, err := stacks.RestartStatusComplete(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartInProgressStatus, statusText)
+	})
+
+	t.Run("with stack pending", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Pending", false), nil).Once()
+		_, statusText, err := stacks.RestartStatusComplete(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartInProgressStatus, statusText)
+	})
+
+	t.Run("with stack failed", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Failed", false), nil).Once()
+		_, _, err := stacks.RestartStatusComplete(context.TODO(), client, v2.Stack(mockStack))()
+		assert.Error(t, err)
+	})
+
+	t.Run("with restart complete", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(http.StatusOK, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(http.StatusOK, "Ready", false), nil).Once()
+		_, statusText, err := stacks.RestartStatusComplete(context.TODO(), client, v2.Stack(mockStack))()
+		assert.NoError(t, err)
+		assert.Equal(t, stacks.RestartCompleteStatus, statusText)
+	})
+}
+
+func Test_IsRestartComplete(t *testing.T) {
+	ready, notReady, initiated, notInitiated := true, false, true, false
+
+	assert.True(t, stacks.IsRestartComplete([]v2.RestartStatus{}))
+	assert.True(t, stacks.IsRestartComplete([]v2.RestartStatus{{RollingRestartInitiated: &notInitiated, ServiceReady: &ready}}))
+	assert.False(t, stacks.IsRestartComplete([]v2.RestartStatus{{RollingRestartInitiated: &initiated, ServiceReady: &ready}}))
+	assert.False(t, stacks.IsRestartComplete([]v2.RestartStatus{{RollingRestartInitiated: &notInitiated, ServiceReady: &ready}, {ServiceReady: &notReady}}))
+}
+
+func genStackResp(code int, infrastructureStatus string, restartRequired bool) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		stackStatus := v2.StackStatus{}
+		stackStatus.Infrastructure.Status = &infrastructureStatus
+		stackStatus.Infrastructure.StackType = &mockStackType
+		stackStatus.Infrastructure.StackVersion = &mockStackVersion
+		stackStatus.Messages.RestartRequired = &restartRequired
+		b, _ = json.Marshal(map[string]interface{}{"status": stackStatus})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genRestartStatusResp(code int, rollingRestartInitiated bool, serviceReady bool) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		captain := "sh1"
+		b, _ = json.Marshal(map[string]interface{}{"shcStatus": []v2.RestartStatus{
+			{Captain: &captain, RollingRestartInitiated: &rollingRestartInitiated, ServiceReady: &serviceReady},
+		}})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	return genResp(code, b)
+}
+
+func genResp(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
@@ -0,0 +1,94 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// RestartInitiationTimeout bounds the wait for a rolling restart to be initiated after the change that requires it is accepted
+	RestartInitiationTimeout = 10 * time.Minute
+)
+
+var (
+	PendingStatusRestartStarted = []string{RestartNotStartedStatus, http.StatusText(http.StatusTooManyRequests)}
+	TargetStatusRestartStarted  = []string{RestartInProgressStatus}
+	PendingStatusRestart        = []string{RestartInProgressStatus, http.StatusText(http.StatusTooManyRequests)}
+	TargetStatusRestart         = []string{RestartCompleteStatus}
+)
+
+// WaitStackRead Handles retry logic for GET requests for the stack status
+func WaitStackRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (*v2.StackStatus, error) {
+	waitStackRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, StackStatusRead(ctx, acsClient, stack))
+
+	output, err := waitStackRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading stack (%s) status: %s", stack, err))
+		return nil, err
+	}
+	stackStatus := output.(*v2.StackStatus)
+
+	return stackStatus, nil
+}
+
+// WaitStackRestart Handles retry logic for POST requests to trigger a rolling restart of the stack, returns the ACS request ID
+func WaitStackRestart(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) (string, error) {
+	waitStackRestartAccepted := wait.GenerateWriteStateChangeConf(StackStatusRestart(ctx, acsClient, stack))
+
+	rawResp, err := waitStackRestartAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for stack (%s) to be restarted: %s", stack, err))
+		return "", err
+	}
+
+	resp := rawResp.(*http.Response)
+	requestID := resp.Header.Get("X-REQUEST-ID")
+
+	// Log to user that request submitted and restart in progress
+	tflog.Info(ctx, fmt.Sprintf("Restart response status code for stack (%s): %d\n", stack, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for stack (%s) restart: %s\n", stack, requestID))
+
+	return requestID, nil
+}
+
+// WaitRestartStarted Handles retry logic for polling restart status until a rolling restart is observed, fails if no
+// rolling restart is initiated within RestartInitiationTimeout
+func WaitRestartStarted(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) error {
+	waitRestartStarted := wait.GenerateReadStateChangeConf(PendingStatusRestartStarted, TargetStatusRestartStarted, RestartStatusStarted(ctx, acsClient, stack))
+	waitRestartStarted.Timeout = RestartInitiationTimeout
+
+	_, err := waitRestartStarted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for stack (%s) restart to be initiated: %s", stack, err))
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restart initiated for stack (%s)\n", stack))
+	return nil
+}
+
+// WaitRestartComplete Handles retry logic for polling restart and stack status until a rolling restart has been
+// initiated and has then completed. The stack is idle before the restart is initiated, so completion is only checked
+// once the restart has been observed
+func WaitRestartComplete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) error {
+	if err := WaitRestartStarted(ctx, acsClient, stack); err != nil {
+		return err
+	}
+
+	waitRestartComplete := wait.GenerateReadStateChangeConf(PendingStatusRestart, TargetStatusRestart, RestartStatusComplete(ctx, acsClient, stack))
+
+	_, err := waitRestartComplete.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for stack (%s) restart to complete: %s", stack, err))
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restart completed for stack (%s)\n", stack))
+	return nil
+}
@@ -0,0 +1,91 @@
+package stacks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/stacks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack = "mock-stack"
+)
+
+var (
+	mockStackType    = "Victoria"
+	mockStackVersion = "9.1.2308"
+)
+
+func Test_WaitStackRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(429, "", false), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Ready", true), nil).Once()
+		stackStatus, err := stacks.WaitStackRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		assert.True(t, *stackStatus.Messages.RestartRequired)
+		assert.Equal(t, mockStackType, *stackStatus.Infrastructure.StackType)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(403, "", false), nil).Once()
+		_, err := stacks.WaitStackRead(context.TODO(), client, v2.Stack(mockStack))
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitStackRestart(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(429, nil), nil).Once()
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(202, nil), nil).Once()
+		_, err := stacks.WaitStackRestart(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("RestartStack", mock.Anything, v2.Stack(mockStack)).Return(genResp(400, nil), nil).Once()
+		_, err := stacks.WaitStackRestart(context.TODO(), client, v2.Stack(mockStack))
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitRestartComplete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with restart in progress then complete", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, true, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, true, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Ready", false), nil).Once()
+		err := stacks.WaitRestartComplete(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with stack idle before restart is initiated", func(t *testing.T) {
+		// the waiter must not return on the idle polls before the restart is observed
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, false, true), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, false, true), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(429, false, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, true, false), nil).Once()
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(genRestartStatusResp(200, false, true), nil).Once()
+		client.On("DescribeStack", mock.Anything, v2.Stack(mockStack)).Return(genStackResp(200, "Ready", false), nil).Once()
+		err := stacks.WaitRestartComplete(context.TODO(), client, v2.Stack(mockStack))
+		assert.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("RestartStatus", mock.Anything, v2.Stack(mockStack)).Return(nil, errors.New("some error")).Once()
+		err := stacks.WaitRestartComplete(context.TODO(), client, v2.Stack(mockStack))
+		assert.Error(t, err)
+	})
+}
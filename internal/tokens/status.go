@@ -0,0 +1,147 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/status"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// TokenStatusEnabled is the status of a token that can be used to authenticate
+	TokenStatusEnabled = "enabled"
+)
+
+var GeneralRetryableStatusCodes = map[int]string{
+	http.StatusTooManyRequests: http.StatusText(http.StatusTooManyRequests),
+}
+
+// tokenInfoBody accepts the token info both at the top level of the response and nested under tokeninfo
+type tokenInfoBody struct {
+	v2.TokenInfo
+	Tokeninfo *v2.TokenInfo `json:"tokeninfo,omitempty"`
+}
+
+// tokenListBody is the response of ListTokens
+type tokenListBody struct {
+	Tokens *[]v2.TokenInfo `json:"tokens,omitempty"`
+}
+
+// TokenStatusCreate returns StateRefreshFunc that makes POST request, checks if request was successful, and returns the created token
+func TokenStatusCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createTokenRequest v2.CreateTokenJSONRequestBody) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := acsClient.CreateToken(ctx, stack, createTokenRequest)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+		_, statusText, statusErr := status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+		if statusErr != nil {
+			return nil, statusText, statusErr
+		}
+
+		var token *v2.TokenInfo
+		if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusOK {
+			if token, err = parseTokenInfo(bodyBytes); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		return token, statusText, nil
+	}
+}
+
+// TokenStatusRead returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the token info
+func TokenStatusRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, tokenID string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.GetTokenInfo(ctx, stack, v2.TokenID(tokenID))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		token := &v2.TokenInfo{}
+		if resp.StatusCode == http.StatusOK {
+			if token, err = parseTokenInfo(bodyBytes); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return token, status, nil
+	}
+}
+
+// TokenStatusList returns StateRefreshFunc that makes GET request, checks if request was successful, and returns the tokens of the page
+func TokenStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListTokensParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListTokens(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		tokens := make([]v2.TokenInfo, 0)
+		if resp.StatusCode == http.StatusOK {
+			var body tokenListBody
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.Tokens != nil {
+				tokens = *body.Tokens
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return tokens, status, nil
+	}
+}
+
+// TokenStatusDelete returns StateRefreshFunc that makes DELETE request and checks if request was accepted
+func TokenStatusDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, tokenID string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DeleteToken(ctx, stack, v2.TokenID(tokenID))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		return status.ProcessResponse(resp, wait.TargetStatusResourceChange, wait.PendingStatusCRUD)
+	}
+}
+
+func parseTokenInfo(bodyBytes []byte) (*v2.TokenInfo, error) {
+	var body tokenInfoBody
+	if err := json.Unmarshal(bodyBytes, &body); err != nil {
+		return nil, err
+	}
+	if body.Tokeninfo != nil {
+		return body.Tokeninfo, nil
+	}
+	return &body.TokenInfo, nil
+}
@@ -0,0 +1,126 @@
+package tokens_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_TokenStatusCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mockCreateRequest).Return(genTokenResp(http.StatusOK, mockToken), nil).Once()
+		output, statusText, err := tokens.TokenStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, mockTokenID, output.(*v2.TokenInfo).Id)
+		assert.Equal(t, mockTokenValue, *output.(*v2.TokenInfo).Token)
+	})
+
+	t.Run("with token info nested in response", func(t *testing.T) {
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mockCreateRequest).Return(genTokenResp(http.StatusOK, map[string]interface{}{"tokeninfo": mockToken}), nil).Once()
+		output, _, err := tokens.TokenStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)()
+		assert.NoError(t, err)
+		assert.Equal(t, mockTokenID, output.(*v2.TokenInfo).Id)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := tokens.TokenStatusCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)()
+		assert.Error(t, err)
+	})
+}
+
+func Test_TokenStatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(http.StatusOK, mockToken), nil).Once()
+		output, statusText, err := tokens.TokenStatusRead(context.TODO(), client, v2.Stack(mockStack), mockTokenID)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Equal(t, tokens.TokenStatusEnabled, output.(*v2.TokenInfo).Status)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(http.StatusTooManyRequests, nil), nil).Once()
+		_, statusText, err := tokens.TokenStatusRead(context.TODO(), client, v2.Stack(mockStack), mockTokenID)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), statusText)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(http.StatusNotFound, nil), nil).Once()
+		output, statusText, err := tokens.TokenStatusRead(context.TODO(), client, v2.Stack(mockStack), mockTokenID)()
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), statusText)
+	})
+}
+
+func Test_TokenStatusList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("ListTokens", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListTokensParams) bool {
+			return params != nil && params.Username != nil && *params.Username == mockUser
+		})).Return(genTokenResp(http.StatusOK, map[string]interface{}{"tokens": []v2.TokenInfo{mockToken}}), nil).Once()
+		username := v2.Username(mockUser)
+		output, statusText, err := tokens.TokenStatusList(context.TODO(), client, v2.Stack(mockStack), v2.ListTokensParams{Username: &username})()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+		assert.Len(t, output.([]v2.TokenInfo), 1)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("ListTokens", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := tokens.TokenStatusList(context.TODO(), client, v2.Stack(mockStack), v2.ListTokensParams{})()
+		assert.Error(t, err)
+	})
+}
+
+func Test_TokenStatusDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DeleteToken", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(http.StatusOK, nil), nil).Once()
+		_, statusText, err := tokens.TokenStatusDelete(context.TODO(), client, v2.Stack(mockStack), mockTokenID)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), statusText)
+	})
+
+	t.Run("with some client interface error", func(t *testing.T) {
+		client.On("DeleteToken", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, _, err := tokens.TokenStatusDelete(context.TODO(), client, v2.Stack(mockStack), mockTokenID)()
+		assert.Error(t, err)
+	})
+}
+
+func genTokenResp(code int, body interface{}) *http.Response {
+	var b []byte
+	if code == http.StatusOK || code == http.StatusAccepted {
+		b, _ = json.Marshal(body)
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
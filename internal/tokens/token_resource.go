@@ -0,0 +1,308 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
+	"github.com/splunk/terraform-provider-scp/internal/errors"
+)
+
+const (
+	ResourceKey = "scp_token"
+
+	schemaKeyUser           = "user"
+	schemaKeyAudience       = "audience"
+	schemaKeyExpiresOn      = "expires_on"
+	schemaKeyToken          = "token"
+	schemaKeyStatus         = "status"
+	schemaKeyExpirationTime = "expiration_time"
+	schemaKeyNotBefore      = "not_before"
+	schemaKeyLastUsed       = "last_used"
+	schemaKeyLastUsedIP     = "last_used_ip"
+)
+
+func tokenResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyUser: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The user the token is created for. Can not be updated after creation.",
+		},
+		schemaKeyAudience: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			Description:      "The audience of the token, a short description of its intended use. Can not be updated after creation.",
+		},
+		schemaKeyExpiresOn: {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Description: "When the token expires, either an absolute time (RFC3339) or a time relative to creation such as `+90d`. " +
+				"Defaults to the ACS default expiration if not set. Can not be updated after creation.",
+		},
+		schemaKeyToken: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The token value. Only returned when the token is created, imported tokens do not have a value.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the token, for example `enabled` or `disabled`.",
+		},
+		schemaKeyExpirationTime: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the token expires.",
+		},
+		schemaKeyNotBefore: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) before which the token can not be used.",
+		},
+		schemaKeyLastUsed: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time (RFC3339) at which the token was last used. Empty if the token has not been used.",
+		},
+		schemaKeyLastUsedIP: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address the token was last used from. Empty if the token has not been used.",
+		},
+	}
+}
+
+func ResourceToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Token Resource. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageAuthTokensACS " +
+			"for more latest, detailed information on attribute requirements and the ACS Tokens API.",
+
+		CreateContext: resourceTokenCreate,
+		ReadContext:   resourceTokenRead,
+		DeleteContext: resourceTokenDelete,
+		CustomizeDiff: customizeTokenDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTokenImport,
+		},
+
+		Schema: tokenResourceSchema(),
+	}
+}
+
+func resourceTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	createTokenRequest := v2.CreateTokenJSONRequestBody{
+		User:     d.Get(schemaKeyUser).(string),
+		Audience: d.Get(schemaKeyAudience).(string),
+	}
+	if expiresOn, ok := d.GetOk(schemaKeyExpiresOn); ok {
+		expiresOnVal := expiresOn.(string)
+		createTokenRequest.ExpiresOn = &expiresOnVal
+	}
+
+	var token *v2.TokenInfo
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() (err error) {
+		token, err = WaitTokenCreate(ctx, acsClient, stack, createTokenRequest)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("Error submitting request for token of user (%s) to be created: %s", createTokenRequest.User, err)
+	}
+	if token.Id == "" {
+		return diag.Errorf("Error creating token of user (%s): ACS did not return a token ID", createTokenRequest.User)
+	}
+
+	// The token value is only returned on creation, so it is set here and never overwritten by read
+	if err := d.Set(schemaKeyToken, token.Token); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set ID of token resource to the token ID to indicate token has been created
+	d.SetId(token.Id)
+	tflog.Info(ctx, fmt.Sprintf("Created token resource: %s\n", token.Id))
+
+	// Call read to set attributes of token
+	return resourceTokenRead(ctx, d, m)
+}
+
+func resourceTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	tokenID := d.Id()
+
+	token, err := WaitTokenRead(ctx, acsClient, stack, tokenID)
+	if err != nil {
+		// if token not found set id of resource to empty string to remove from state
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Removing token from state. Not Found error while reading token (%s): %s.", tokenID, err))
+			d.SetId("")
+			return nil //if we return an error here, the set id will not take effect and state will be preserved
+		}
+		return diag.Errorf("Error reading token (%s): %s", tokenID, err)
+	}
+
+	if err := d.Set(schemaKeyUser, token.User); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyAudience, token.Audience); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyStatus, token.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyExpirationTime, formatTime(&token.ExpiresOn)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyNotBefore, formatTime(&token.NotBefore)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyLastUsed, formatTime(token.LastUsed)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyLastUsedIP, token.LastUsedIP); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve client and stack from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	tokenID := d.Id()
+
+	if err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitTokenDelete(ctx, acsClient, stack, tokenID)
+	}); err != nil {
+		if errors.IsNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("Token (%s) already removed: %s.", tokenID, err))
+			return nil
+		}
+		return diag.Errorf("Error deleting token (%s): %s", tokenID, err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted token resource: %s\n", tokenID))
+	return nil
+}
+
+// customizeTokenDiff proposes a replacement when the token in state has expired or has been disabled outside of terraform
+func customizeTokenDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	tokenStatus := d.Get(schemaKeyStatus).(string)
+	if !TokenNeedsRecreate(tokenStatus, d.Get(schemaKeyExpirationTime).(string), time.Now()) {
+		return nil
+	}
+
+	// the replacement is forced on the attribute that requires it, its value in state is never empty so marking it as
+	// computed is a change even for imported tokens without a token value or tokens without an expiration time
+	forceNewKey := schemaKeyExpirationTime
+	if tokenStatus != "" && !strings.EqualFold(tokenStatus, TokenStatusEnabled) {
+		forceNewKey = schemaKeyStatus
+	}
+	for _, key := range []string{schemaKeyToken, schemaKeyStatus, schemaKeyExpirationTime} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return d.ForceNew(forceNewKey)
+}
+
+// resourceTokenImport accepts either a token ID or user/audience, the latter is resolved to the enabled token of the
+// user with that audience
+func resourceTokenImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	user, audience, found := strings.Cut(d.Id(), "/")
+	if !found {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	tokens, err := WaitTokenList(ctx, acsClient, stack, user, TokenStatusEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tokens of user (%s): %s", user, err)
+	}
+
+	token, err := FindToken(tokens, user, audience)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(token.Id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// TokenNeedsRecreate returns true if the token is no longer enabled or its expiration time has passed
+func TokenNeedsRecreate(tokenStatus string, expirationTime string, now time.Time) bool {
+	if tokenStatus != "" && !strings.EqualFold(tokenStatus, TokenStatusEnabled) {
+		return true
+	}
+	if expirationTime == "" {
+		return false
+	}
+	expiresOn, err := time.Parse(time.RFC3339, expirationTime)
+	if err != nil {
+		return false
+	}
+	return !expiresOn.After(now)
+}
+
+// FindToken returns the only token of the user with the audience, errors if there is none or more than one
+func FindToken(tokens []v2.TokenInfo, user string, audience string) (*v2.TokenInfo, error) {
+	var matched []v2.TokenInfo
+	for _, token := range tokens {
+		if token.User == user && token.Audience == audience {
+			matched = append(matched, token)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("no enabled token found for user (%s) with audience (%s)", user, audience)
+	case 1:
+		return &matched[0], nil
+	default:
+		return nil, fmt.Errorf("found %d enabled tokens for user (%s) with audience (%s), import by token ID instead", len(matched), user, audience)
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
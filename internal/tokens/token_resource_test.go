@@ -0,0 +1,93 @@
+package tokens_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+DISCLAIMER: NO ACCEPTANCE TEST FOR TOKEN RESOURCE
+
+Tokens can only be created for users that authenticate against the stack, and the acceptance test environment only
+provides the token the tests run with, not a user of the acceptance test stack to create tokens for.
+*/
+
+func Test_TokenNeedsRecreate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.False(t, tokens.TokenNeedsRecreate("enabled", "2027-01-01T00:00:00Z", now))
+	assert.False(t, tokens.TokenNeedsRecreate("", "", now))
+	assert.True(t, tokens.TokenNeedsRecreate("disabled", "2027-01-01T00:00:00Z", now))
+	assert.True(t, tokens.TokenNeedsRecreate("enabled", "2025-12-31T23:59:59Z", now))
+	assert.True(t, tokens.TokenNeedsRecreate("enabled", "2026-01-01T00:00:00Z", now))
+}
+
+func Test_ResourceTokenDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"user": mockUser, "audience": mockAudience})
+
+	t.Run("with disabled token without expiration", func(t *testing.T) {
+		diff, err := tokens.ResourceToken().Diff(context.TODO(), genTokenState("disabled", "", "token-value"), config, nil)
+		assert.NoError(t, err)
+		assert.True(t, diff.RequiresNew())
+	})
+
+	t.Run("with disabled imported token without expiration", func(t *testing.T) {
+		diff, err := tokens.ResourceToken().Diff(context.TODO(), genTokenState("disabled", "", ""), config, nil)
+		assert.NoError(t, err)
+		assert.True(t, diff.RequiresNew())
+	})
+
+	t.Run("with expired token", func(t *testing.T) {
+		diff, err := tokens.ResourceToken().Diff(context.TODO(), genTokenState("enabled", "2020-01-01T00:00:00Z", "token-value"), config, nil)
+		assert.NoError(t, err)
+		assert.True(t, diff.RequiresNew())
+	})
+
+	t.Run("with expired token without status", func(t *testing.T) {
+		diff, err := tokens.ResourceToken().Diff(context.TODO(), genTokenState("", "2020-01-01T00:00:00Z", ""), config, nil)
+		assert.NoError(t, err)
+		assert.True(t, diff.RequiresNew())
+	})
+
+	t.Run("with enabled token", func(t *testing.T) {
+		diff, err := tokens.ResourceToken().Diff(context.TODO(), genTokenState("enabled", "", "token-value"), config, nil)
+		assert.NoError(t, err)
+		assert.False(t, diff != nil && diff.RequiresNew())
+	})
+}
+
+func Test_FindToken(t *testing.T) {
+	other := mockToken
+	other.Id = "other-token-id"
+	other.Audience = "other-audience"
+
+	token, err := tokens.FindToken([]v2.TokenInfo{other, mockToken}, mockUser, mockAudience)
+	assert.NoError(t, err)
+	assert.Equal(t, mockTokenID, token.Id)
+
+	_, err = tokens.FindToken([]v2.TokenInfo{other}, mockUser, mockAudience)
+	assert.Error(t, err)
+
+	_, err = tokens.FindToken([]v2.TokenInfo{mockToken, mockToken}, mockUser, mockAudience)
+	assert.Error(t, err)
+}
+
+func genTokenState(tokenStatus string, expirationTime string, tokenValue string) *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: mockTokenID,
+		Attributes: map[string]string{
+			"id":              mockTokenID,
+			"user":            mockUser,
+			"audience":        mockAudience,
+			"token":           tokenValue,
+			"status":          tokenStatus,
+			"expiration_time": expirationTime,
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	// TokenPageSize is the number of tokens requested per ListTokens call
+	TokenPageSize = 100
+)
+
+// WaitTokenCreate Handles retry logic for POST requests for create lifecycle function
+func WaitTokenCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createTokenRequest v2.CreateTokenJSONRequestBody) (*v2.TokenInfo, error) {
+	waitTokenCreateAccepted := wait.GenerateWriteStateChangeConf(TokenStatusCreate(ctx, acsClient, stack, createTokenRequest))
+
+	output, err := waitTokenCreateAccepted.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error submitting request for token of user (%s) to be created: %s", createTokenRequest.User, err))
+		return nil, err
+	}
+
+	token := output.(*v2.TokenInfo)
+
+	tflog.Info(ctx, fmt.Sprintf("Create request accepted for token of user (%s), token ID: %s\n", createTokenRequest.User, token.Id))
+
+	return token, nil
+}
+
+// WaitTokenRead Handles retry logic for GET requests for the read lifecycle function
+func WaitTokenRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, tokenID string) (*v2.TokenInfo, error) {
+	waitTokenRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, TokenStatusRead(ctx, acsClient, stack, tokenID))
+
+	output, err := waitTokenRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading token (%s): %s", tokenID, err))
+		return nil, err
+	}
+	token := output.(*v2.TokenInfo)
+
+	return token, nil
+}
+
+// WaitTokenList Handles retry logic for GET requests listing the tokens of a user, following count/offset pagination
+// until a partial page is returned
+func WaitTokenList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, user string, tokenStatus string) ([]v2.TokenInfo, error) {
+	username := v2.Username(user)
+	statusParam := v2.TokenStatus(tokenStatus)
+	count := v2.Count(TokenPageSize)
+
+	tokens := make([]v2.TokenInfo, 0)
+	for offset := v2.Offset(0); ; offset += TokenPageSize {
+		pageOffset := offset
+		params := v2.ListTokensParams{Count: &count, Offset: &pageOffset, Username: &username, Status: &statusParam}
+		waitTokenList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, TokenStatusList(ctx, acsClient, stack, params))
+
+		output, err := waitTokenList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing tokens of user (%s): %s", user, err))
+			return nil, err
+		}
+		page := output.([]v2.TokenInfo)
+		tokens = append(tokens, page...)
+
+		if len(page) < TokenPageSize {
+			return tokens, nil
+		}
+	}
+}
+
+// WaitTokenDelete Handles retry logic for DELETE requests for the delete lifecycle function
+func WaitTokenDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, tokenID string) error {
+	waitTokenDelete := wait.GenerateWriteStateChangeConf(TokenStatusDelete(ctx, acsClient, stack, tokenID))
+
+	rawResp, err := waitTokenDelete.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting token (%s): %s", tokenID, err))
+		return err
+	}
+
+	resp := rawResp.(*http.Response)
+
+	//Log to user that request submitted and deletion in progress
+	tflog.Info(ctx, fmt.Sprintf("Delete response status code for token (%s): %d\n", tokenID, resp.StatusCode))
+	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for token (%s): %s\n", tokenID, resp.Header.Get("X-REQUEST-ID")))
+	return nil
+}
@@ -0,0 +1,136 @@
+package tokens_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack      = "mock-stack"
+	mockUser       = "pipeline-svc"
+	mockAudience   = "ci-pipeline"
+	mockTokenID    = "mock-token-id"
+	mockTokenValue = "mock-token-value"
+)
+
+var (
+	mockValue         = mockTokenValue
+	mockCreateRequest = v2.CreateTokenJSONRequestBody{User: mockUser, Audience: mockAudience}
+	mockToken         = v2.TokenInfo{
+		Id:        mockTokenID,
+		User:      mockUser,
+		Audience:  mockAudience,
+		Status:    tokens.TokenStatusEnabled,
+		Token:     &mockValue,
+		ExpiresOn: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotBefore: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+var (
+	clientErrorCodes = []int{400, 401, 403, 404, 409}
+	serverErrorCodes = []int{501, 500, 503}
+)
+
+func Test_WaitTokenCreate(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with some client interface error", func(_ *testing.T) {
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(nil, errors.New("some error")).Once()
+		_, err := tokens.WaitTokenCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)
+		assert.Error(t, err)
+	})
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mockCreateRequest).Return(genTokenResp(429, nil), nil).Once()
+		client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mockCreateRequest).Return(genTokenResp(200, mockToken), nil).Once()
+		token, err := tokens.WaitTokenCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)
+		assert.NoError(t, err)
+		assert.Equal(t, mockTokenID, token.Id)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("CreateToken", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genTokenResp(statusCode, nil), nil).Once()
+				_, err := tokens.WaitTokenCreate(context.TODO(), client, v2.Stack(mockStack), mockCreateRequest)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitTokenRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(429, nil), nil).Once()
+		client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(200, mockToken), nil).Once()
+		token, err := tokens.WaitTokenRead(context.TODO(), client, v2.Stack(mockStack), mockTokenID)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAudience, token.Audience)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("GetTokenInfo", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(statusCode, nil), nil).Once()
+				_, err := tokens.WaitTokenRead(context.TODO(), client, v2.Stack(mockStack), mockTokenID)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_WaitTokenList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		fullPage := make([]v2.TokenInfo, tokens.TokenPageSize)
+		client.On("ListTokens", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListTokensParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(genTokenResp(200, map[string]interface{}{"tokens": fullPage}), nil).Once()
+		client.On("ListTokens", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListTokensParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == tokens.TokenPageSize
+		})).Return(genTokenResp(200, map[string]interface{}{"tokens": []v2.TokenInfo{mockToken}}), nil).Once()
+		list, err := tokens.WaitTokenList(context.TODO(), client, v2.Stack(mockStack), mockUser, tokens.TokenStatusEnabled)
+		assert.NoError(t, err)
+		assert.Len(t, list, tokens.TokenPageSize+1)
+	})
+
+	t.Run("with unexpected http response", func(t *testing.T) {
+		client.On("ListTokens", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(genTokenResp(400, nil), nil).Once()
+		_, err := tokens.WaitTokenList(context.TODO(), client, v2.Stack(mockStack), mockUser, tokens.TokenStatusEnabled)
+		assert.Error(t, err)
+	})
+}
+
+func Test_WaitTokenDelete(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retryable response 429", func(t *testing.T) {
+		client.On("DeleteToken", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(429, nil), nil).Once()
+		client.On("DeleteToken", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(200, nil), nil).Once()
+		err := tokens.WaitTokenDelete(context.TODO(), client, v2.Stack(mockStack), mockTokenID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with unexpected http responses", func(t *testing.T) {
+		for _, statusCode := range append(clientErrorCodes, serverErrorCodes...) {
+			t.Run(fmt.Sprintf("with unexpected status %v", statusCode), func(t *testing.T) {
+				client.On("DeleteToken", mock.Anything, v2.Stack(mockStack), v2.TokenID(mockTokenID)).Return(genTokenResp(statusCode, nil), nil).Once()
+				err := tokens.WaitTokenDelete(context.TODO(), client, v2.Stack(mockStack), mockTokenID)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
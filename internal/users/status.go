@@ -135,3 +135,38 @@ func VerifyUserUpdate(patchRequest v2.PatchUserJSONRequestBody, user v2.UsersRes
 	}
 	return true
 }
+
+// UserStatusList returns StateRefreshFunc that makes GET request for a page of users, checks if request was successful, and returns the users of the page
+func UserStatusList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, params v2.ListUsersParams) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.ListUsers(ctx, stack, &params)
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+		bodyBytes, _ := io.ReadAll(resp.Body)
+
+		if _, ok := GeneralRetryableStatusCodes[resp.StatusCode]; !ok && resp.StatusCode != http.StatusOK {
+			return nil, http.StatusText(resp.StatusCode), &resource.UnexpectedStateError{
+				State:         http.StatusText(resp.StatusCode),
+				ExpectedState: wait.TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		users := make([]v2.UsersResponse, 0)
+		if resp.StatusCode == http.StatusOK {
+			var body struct {
+				Users *[]v2.UsersResponse `json:"users,omitempty"`
+			}
+			if err = json.Unmarshal(bodyBytes, &body); err != nil {
+				return nil, "", &resource.UnexpectedStateError{LastError: err}
+			}
+			if body.Users != nil {
+				users = *body.Users
+			}
+		}
+		status := http.StatusText(resp.StatusCode)
+		return users, status, nil
+	}
+}
@@ -0,0 +1,138 @@
+package users
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKey = "scp_user"
+
+	schemaKeyCapabilities = "capabilities"
+)
+
+// userAttributesSchema returns the computed attributes of a user as returned by ACS
+func userAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the user.",
+		},
+		schemaKeyEmail: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The email of the user.",
+		},
+		schemaKeyFullName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The full name of the user.",
+		},
+		schemaKeyDefaultApp: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The default app of the user.",
+		},
+		schemaKeyDefaultAppSource: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Default app source of the user.",
+		},
+		schemaKeyRoles: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The roles assigned to the user.",
+		},
+		schemaKeyCapabilities: {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The capabilities of the user, inherited from all of the user's roles.",
+		},
+		schemaKeyLockedOut: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user account has been locked out.",
+		},
+		schemaKeyLastSuccessfulLogin: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last successful login timestamp of the user. Empty if the user never logged in.",
+		},
+	}
+}
+
+func userDataSourceSchema() map[string]*schema.Schema {
+	attributes := userAttributesSchema()
+	attributes[schemaKeyName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the user.",
+	}
+	return attributes
+}
+
+func DataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "User Data Source. Use this data source to review the roles and capabilities of a user you do not wish " +
+			"Terraform to execute write operations on. Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageRoles " +
+			"for more latest, detailed information on attribute requirements and the ACS Users API.",
+
+		ReadContext: dataSourceUserRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: userDataSourceSchema(),
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	userName := d.Get(schemaKeyName).(string)
+
+	user, err := WaitUserRead(ctx, acsClient, stack, userName)
+	if err != nil {
+		return diag.Errorf("Error reading user (%s): %s", userName, err)
+	}
+
+	for key, value := range FlattenUser(*user) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return nil
+}
+
+// FlattenUser converts a user response to the map of attributes defined by userAttributesSchema
+func FlattenUser(user v2.UsersResponse) map[string]interface{} {
+	lastSuccessfulLogin := ""
+	if user.LastSuccessfulLogin != nil {
+		lastSuccessfulLogin = *user.LastSuccessfulLogin
+	}
+	return map[string]interface{}{
+		schemaKeyName:                user.Name,
+		schemaKeyEmail:               user.Email,
+		schemaKeyFullName:            user.FullName,
+		schemaKeyDefaultApp:          user.DefaultApp,
+		schemaKeyDefaultAppSource:    user.DefaultAppSource,
+		schemaKeyRoles:               user.Roles,
+		schemaKeyCapabilities:        user.Capabilities,
+		schemaKeyLockedOut:           user.LockedOut,
+		schemaKeyLastSuccessfulLogin: lastSuccessfulLogin,
+	}
+}
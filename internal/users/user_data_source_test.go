@@ -0,0 +1,59 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/users"
+	"github.com/stretchr/testify/assert"
+)
+
+const userDataSourceTemplate = `
+data "scp_user" "test" {
+	name = "sc_admin"
+}
+`
+
+func TestAcc_SplunkCloudUser_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: userDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scp_user.test", "name", "sc_admin"),
+					resource.TestCheckResourceAttrSet("data.scp_user.test", "roles.#"),
+					resource.TestCheckResourceAttrSet("data.scp_user.test", "capabilities.#"),
+					resource.TestCheckResourceAttrSet("data.scp_user.test", "locked_out"),
+				),
+			},
+		},
+	})
+}
+
+func Test_FlattenUser(t *testing.T) {
+	t.Run("with last successful login", func(t *testing.T) {
+		lastSuccessfulLogin := "2026-10-01T10:00:00Z"
+		flattened := users.FlattenUser(v2.UsersResponse{
+			Name:                mockUserName,
+			Roles:               []string{"user"},
+			Capabilities:        mockCapabilities,
+			LockedOut:           true,
+			LastSuccessfulLogin: &lastSuccessfulLogin,
+		})
+		assert.Equal(t, mockUserName, flattened["name"])
+		assert.Equal(t, []string{"user"}, flattened["roles"])
+		assert.Equal(t, mockCapabilities, flattened["capabilities"])
+		assert.Equal(t, true, flattened["locked_out"])
+		assert.Equal(t, lastSuccessfulLogin, flattened["last_successful_login"])
+	})
+
+	t.Run("without last successful login", func(t *testing.T) {
+		flattened := users.FlattenUser(v2.UsersResponse{Name: mockUserName})
+		assert.Equal(t, "", flattened["last_successful_login"])
+		assert.Equal(t, false, flattened["locked_out"])
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
 	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/deployments"
 	"github.com/splunk/terraform-provider-scp/internal/errors"
 	"github.com/splunk/terraform-provider-scp/internal/status"
 	"github.com/splunk/terraform-provider-scp/internal/utils"
@@ -174,7 +175,9 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	tflog.Info(ctx, fmt.Sprintf("%+v\n", createRequest))
 
-	err := WaitUserCreate(ctx, acsClient, stack, createParam, createRequest)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitUserCreate(ctx, acsClient, stack, createParam, createRequest)
+	})
 	if err != nil {
 		if errors.IsConflictError(err) {
 			return diag.Errorf("%s", fmt.Sprintf("User (%s) already exists, use a different name to create user or use terraform import to bring current user under terraform management", createRequest.Name))
@@ -271,7 +274,9 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		FederatedSearchManageAck: userParam,
 	}
 
-	err := WaitUserUpdate(ctx, acsClient, stack, patchParam, patchRequest, userName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitUserUpdate(ctx, acsClient, stack, patchParam, patchRequest, userName)
+	})
 	if err != nil {
 		return diag.Errorf("%s", fmt.Sprintf("Error submitting request for user (%s) to be updated: %s", userName, err))
 	}
@@ -294,7 +299,9 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 
 	userName := d.Id()
 
-	err := WaitUserDelete(ctx, acsClient, stack, userName)
+	err := deployments.WaitRetryOnFailedTask(ctx, acsClient, stack, func() error {
+		return WaitUserDelete(ctx, acsClient, stack, userName)
+	})
 	if err != nil {
 		return diag.Errorf("%s", fmt.Sprintf("Error deleting user (%s): %s", userName, err))
 	}
@@ -0,0 +1,89 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/splunk/terraform-provider-scp/client"
+)
+
+const (
+	DataSourceKeyList = "scp_users_list"
+
+	schemaKeyNames = "names"
+	schemaKeyUsers = "users"
+)
+
+func usersListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyNames: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Sorted names of the users of the stack.",
+		},
+		schemaKeyUsers: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: userAttributesSchema(),
+			},
+			Description: "The users of the stack sorted by name.",
+		},
+	}
+}
+
+func DataSourceUsersList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Users List Data Source. Use this data source to list every user of the stack with their roles and " +
+			"capabilities, for example to review access on a stack without managing every account with Terraform. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ManageRoles " +
+			"for more latest, detailed information on attribute requirements and the ACS Users API.",
+
+		ReadContext: dataSourceUsersListRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: usersListDataSourceSchema(),
+	}
+}
+
+func dataSourceUsersListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	users, err := WaitUserList(ctx, acsClient, stack)
+	if err != nil {
+		return diag.Errorf("Error listing users: %s", err)
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+
+	names := make([]string, 0, len(users))
+	flattened := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.Name)
+		flattened = append(flattened, FlattenUser(user))
+	}
+
+	if err := d.Set(schemaKeyNames, names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(schemaKeyUsers, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/users", stack))
+
+	return nil
+}
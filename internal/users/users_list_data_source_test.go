@@ -0,0 +1,28 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+)
+
+const usersListDataSourceTemplate = `
+data "scp_users_list" "test" {}
+`
+
+func TestAcc_SplunkCloudUsersList_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: usersListDataSourceTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scp_users_list.test", "names.#"),
+					resource.TestCheckResourceAttrSet("data.scp_users_list.test", "users.0.roles.#"),
+				),
+			},
+		},
+	})
+}
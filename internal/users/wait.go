@@ -18,6 +18,11 @@ var (
 	TargetStatusResourceDeleted = []string{http.StatusText(200)}
 )
 
+const (
+	// UserPageSize is the number of users requested per ListUsers call
+	UserPageSize = 100
+)
+
 // WaitUserCreate Handles retry logic for POST requests for create lifecycle function
 func WaitUserCreate(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, createParams v2.CreateUserParams, createUserRequest v2.CreateUserJSONRequestBody) error {
 	waitUserCreateAccepted := wait.GenerateWriteStateChangeConf(UserStatusCreate(ctx, acsClient, stack, createParams, createUserRequest))
@@ -103,3 +108,27 @@ func WaitUserDelete(ctx context.Context, acsClient v2.ClientInterface, stack v2.
 	tflog.Info(ctx, fmt.Sprintf("ACS Request ID for user (%s): %s\n", userName, resp.Header.Get("X-REQUEST-ID")))
 	return nil
 }
+
+// WaitUserList Handles retry logic for GET requests listing users, pages through every user of the stack
+func WaitUserList(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack) ([]v2.UsersResponse, error) {
+	count := v2.Count(UserPageSize)
+
+	users := make([]v2.UsersResponse, 0)
+	for offset := v2.Offset(0); ; offset += UserPageSize {
+		pageOffset := offset
+		params := v2.ListUsersParams{Count: &count, Offset: &pageOffset}
+		waitUserList := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, UserStatusList(ctx, acsClient, stack, params))
+
+		output, err := waitUserList.WaitForStateContext(ctx)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error listing users at offset (%d): %s", offset, err))
+			return nil, err
+		}
+		page := output.([]v2.UsersResponse)
+		users = append(users, page...)
+
+		if len(page) < UserPageSize {
+			return users, nil
+		}
+	}
+}
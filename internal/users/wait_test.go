@@ -230,3 +230,41 @@ func Test_WaitUserDelete(t *testing.T) {
 		}
 	})
 }
+
+func Test_WaitUserList(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with multiple pages", func(t *testing.T) {
+		fullPage, _ := json.Marshal(map[string][]v2.UsersResponse{"users": make([]v2.UsersResponse, users.UserPageSize)})
+		lastPage, _ := json.Marshal(map[string][]v2.UsersResponse{"users": {{Name: mockUserName}}})
+		client.On("ListUsers", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListUsersParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == 0
+		})).Return(generateBodyResponse(http.StatusOK, fullPage), nil).Once()
+		client.On("ListUsers", mock.Anything, v2.Stack(mockStack), mock.MatchedBy(func(params *v2.ListUsersParams) bool {
+			return params != nil && params.Offset != nil && *params.Offset == users.UserPageSize
+		})).Return(generateBodyResponse(http.StatusOK, lastPage), nil).Once()
+		userList, err := users.WaitUserList(context.TODO(), client, mockStack)
+		assert.NoError(t, err)
+		assert.Len(t, userList, users.UserPageSize+1)
+		assert.Equal(t, mockUserName, userList[users.UserPageSize].Name)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("ListUsers", mock.Anything, v2.Stack(mockStack), mock.Anything).Return(generateResponse(code), nil).Once()
+				userList, err := users.WaitUserList(context.TODO(), client, mockStack)
+				assert.Error(t, err)
+				assert.Nil(t, userList)
+			})
+		}
+	})
+}
+
+func generateBodyResponse(code int, b []byte) *http.Response {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	_, _ = recorder.Write(b)
+	return recorder.Result()
+}
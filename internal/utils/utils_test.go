@@ -63,3 +63,18 @@ func Test_TestParseSetValues(t *testing.T) {
 	parsedSet := utils.ParseSetValues(values)
 	assert.ElementsMatch(t, parsedSet, testData)
 }
+
+func Test_ClosestMatch(t *testing.T) {
+	candidates := []string{"search", "schedule_search", "list_inputs", "edit_token_http"}
+
+	match, ok := utils.ClosestMatch("schedule_serach", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "schedule_search", match)
+
+	match, ok = utils.ClosestMatch("Search", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "search", match)
+
+	_, ok = utils.ClosestMatch("admin_all_objects", candidates)
+	assert.False(t, ok)
+}
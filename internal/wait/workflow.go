@@ -0,0 +1,97 @@
+package wait
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+)
+
+const (
+	// WorkflowStateInProgress is the state reported for every workflow status that is not terminal
+	WorkflowStateInProgress = "in progress"
+)
+
+var (
+	// TerminalStatusWorkflow are the lower-cased workflow statuses after which a workflow no longer changes
+	TerminalStatusWorkflow = []string{"completed", "succeeded", "success", "failed", "failure", "cancelled", "canceled", "aborted", "timedout", "timed_out"}
+	PendingStatusWorkflow  = []string{WorkflowStateInProgress, http.StatusText(http.StatusTooManyRequests)}
+)
+
+// IsWorkflowTerminal checks if the workflow status is one of TerminalStatusWorkflow, the status is compared case-insensitively
+func IsWorkflowTerminal(status string) bool {
+	for _, terminal := range TerminalStatusWorkflow {
+		if strings.EqualFold(status, terminal) {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkflowStatusTerminal returns StateRefreshFunc that makes GET request for the workflow and checks if request was successful.
+// If the request was successful, we return the workflow with its lower-cased status as state when the status is terminal and
+// WorkflowStateInProgress otherwise
+func WorkflowStatusTerminal(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, workflowName string) resource.StateRefreshFunc {
+	return func() (any, string, error) {
+		resp, err := acsClient.DescribeWorkflow(ctx, stack, v2.WorkflowName(workflowName))
+		if err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+		defer resp.Body.Close()
+
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		statusText := http.StatusText(resp.StatusCode)
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, statusText, nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, statusText, &resource.UnexpectedStateError{
+				State:         statusText,
+				ExpectedState: TargetStatusResourceExists,
+				LastError:     errors.New(string(bodyBytes)),
+			}
+		}
+
+		var workflow v2.DescribeWorkflowResponseObject
+		if err = json.Unmarshal(bodyBytes, &workflow); err != nil {
+			return nil, "", &resource.UnexpectedStateError{LastError: err}
+		}
+
+		status := ""
+		if workflow.Status != nil {
+			status = *workflow.Status
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Workflow (%s) status: %s\n", workflowName, status))
+
+		if IsWorkflowTerminal(status) {
+			return &workflow, strings.ToLower(status), nil
+		}
+		return &workflow, WorkflowStateInProgress, nil
+	}
+}
+
+// WaitWorkflowTerminal Handles retry logic for polling a named workflow until it reaches a terminal state and returns the
+// workflow. A workflow that failed or was cancelled is returned without error, callers decide how to handle its status
+func WaitWorkflowTerminal(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, workflowName string, timeout time.Duration) (*v2.DescribeWorkflowResponseObject, error) {
+	waitWorkflowTerminal := GenerateReadStateChangeConf(PendingStatusWorkflow, TerminalStatusWorkflow, WorkflowStatusTerminal(ctx, acsClient, stack, workflowName))
+	if timeout > 0 {
+		waitWorkflowTerminal.Timeout = timeout
+	}
+
+	output, err := waitWorkflowTerminal.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error waiting for workflow (%s) to reach a terminal state: %s", workflowName, err))
+		return nil, err
+	}
+
+	return output.(*v2.DescribeWorkflowResponseObject), nil
+}
@@ -0,0 +1,129 @@
+package wait_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack        = "mock-stack"
+	mockWorkflowName = "mock-workflow"
+)
+
+func genWorkflowResp(code int, status string) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		name := mockWorkflowName
+		b, _ = json.Marshal(&v2.DescribeWorkflowResponseObject{Name: &name, Status: &status})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
+
+func Test_IsWorkflowTerminal(t *testing.T) {
+	assert.True(t, wait.IsWorkflowTerminal("Completed"))
+	assert.True(t, wait.IsWorkflowTerminal("failed"))
+	assert.True(t, wait.IsWorkflowTerminal("CANCELLED"))
+	assert.False(t, wait.IsWorkflowTerminal("Running"))
+	assert.False(t, wait.IsWorkflowTerminal(""))
+}
+
+func Test_WorkflowStatusTerminal(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with client error", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(nil, errors.New("some error")).Once()
+		_, _, err := wait.WorkflowStatusTerminal(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.Error(t, err)
+	})
+
+	t.Run("with running workflow", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Running"), nil).Once()
+		output, state, err := wait.WorkflowStatusTerminal(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.NoError(t, err)
+		assert.Equal(t, wait.WorkflowStateInProgress, state)
+		assert.Equal(t, "Running", *output.(*v2.DescribeWorkflowResponseObject).Status)
+	})
+
+	t.Run("with terminal workflow", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Failed"), nil).Once()
+		_, state, err := wait.WorkflowStatusTerminal(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.NoError(t, err)
+		assert.Equal(t, "failed", state)
+	})
+
+	t.Run("with rate limit", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusTooManyRequests, ""), nil).Once()
+		output, state, err := wait.WorkflowStatusTerminal(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.NoError(t, err)
+		assert.Nil(t, output)
+		assert.Equal(t, http.StatusText(http.StatusTooManyRequests), state)
+	})
+
+	t.Run("with not found", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusNotFound, ""), nil).Once()
+		_, state, err := wait.WorkflowStatusTerminal(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), state)
+	})
+}
+
+func Test_WaitWorkflowTerminal(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with running then completed workflow", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusTooManyRequests, ""), nil).Once()
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Running"), nil).Once()
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Completed"), nil).Once()
+		workflow, err := wait.WaitWorkflowTerminal(context.TODO(), client, mockStack, mockWorkflowName, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "Completed", *workflow.Status)
+	})
+
+	t.Run("with failed workflow", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Failed"), nil).Once()
+		workflow, err := wait.WaitWorkflowTerminal(context.TODO(), client, mockStack, mockWorkflowName, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "Failed", *workflow.Status)
+	})
+
+	t.Run("with timeout", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK, "Running"), nil).Once()
+		workflow, err := wait.WaitWorkflowTerminal(context.TODO(), client, mockStack, mockWorkflowName, time.Millisecond)
+		assert.Error(t, err)
+		assert.Nil(t, workflow)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, code := range []int{400, 401, 403, 404, 501} {
+			t.Run(fmt.Sprintf("with unexpected response %v", code), func(t *testing.T) {
+				client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(code, ""), nil).Once()
+				workflow, err := wait.WaitWorkflowTerminal(context.TODO(), client, mockStack, mockWorkflowName, 0)
+				assert.Error(t, err)
+				assert.Nil(t, workflow)
+			})
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package workflows_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockStack        = "mock-stack"
+	mockWorkflowName = "mock-workflow"
+)
+
+var (
+	mockStatus    = "Running"
+	mockCreatedAt = "2024-01-01T00:00:00Z"
+)
+
+func genWorkflowResp(code int) *http.Response {
+	var b []byte
+	if code == http.StatusOK {
+		name := mockWorkflowName
+		b, _ = json.Marshal(&v2.DescribeWorkflowResponseObject{Name: &name, Status: &mockStatus, CreatedAt: &mockCreatedAt})
+	} else {
+		b, _ = json.Marshal(&v2.Error{
+			Code:    http.StatusText(code),
+			Message: http.StatusText(code),
+		})
+	}
+	recorder := httptest.NewRecorder()
+	recorder.Header().Add("Content-Type", "json")
+	recorder.WriteHeader(code)
+	if b != nil {
+		_, _ = recorder.Write(b)
+	}
+	return recorder.Result()
+}
+
+func Test_StatusRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with client error", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(nil, errors.New("some error")).Once()
+		_, _, err := workflows.StatusRead(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.Error(t, err)
+	})
+
+	t.Run("with http 200 response", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK), nil).Once()
+		output, state, err := workflows.StatusRead(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusText(http.StatusOK), state)
+		assert.Equal(t, mockStatus, *output.(*v2.DescribeWorkflowResponseObject).Status)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusNotFound), nil).Once()
+		_, state, err := workflows.StatusRead(context.TODO(), client, mockStack, mockWorkflowName)()
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusText(http.StatusNotFound), state)
+	})
+}
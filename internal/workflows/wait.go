@@ -0,0 +1,23 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+// WaitWorkflowRead Handles retry logic for GET requests for the read lifecycle function
+func WaitWorkflowRead(ctx context.Context, acsClient v2.ClientInterface, stack v2.Stack, workflowName string) (*v2.DescribeWorkflowResponseObject, error) {
+	waitWorkflowRead := wait.GenerateReadStateChangeConf(wait.PendingStatusCRUD, wait.TargetStatusResourceExists, StatusRead(ctx, acsClient, stack, workflowName))
+
+	output, err := waitWorkflowRead.WaitForStateContext(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading workflow (%s): %s", workflowName, err))
+		return nil, err
+	}
+
+	return output.(*v2.DescribeWorkflowResponseObject), nil
+}
@@ -0,0 +1,39 @@
+package workflows_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/acs/v2/mocks"
+	"github.com/splunk/terraform-provider-scp/internal/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var unexpectedStatusCodes = []int{400, 401, 403, 404, 409, 501, 503}
+
+func Test_WaitWorkflowRead(t *testing.T) {
+	client := &mocks.ClientInterface{}
+
+	t.Run("with retry on rate limit", func(t *testing.T) {
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusTooManyRequests), nil).Once()
+		client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(http.StatusOK), nil).Once()
+		workflow, err := workflows.WaitWorkflowRead(context.TODO(), client, mockStack, mockWorkflowName)
+		assert.NoError(t, err)
+		assert.Equal(t, mockWorkflowName, *workflow.Name)
+	})
+
+	t.Run("with unexpected response", func(t *testing.T) {
+		for _, code := range unexpectedStatusCodes {
+			t.Run(fmt.Sprintf("with unexpected status %v", code), func(t *testing.T) {
+				client.On("DescribeWorkflow", mock.Anything, v2.Stack(mockStack), v2.WorkflowName(mockWorkflowName)).Return(genWorkflowResp(code), nil).Once()
+				workflow, err := workflows.WaitWorkflowRead(context.TODO(), client, mockStack, mockWorkflowName)
+				assert.Error(t, err)
+				assert.Nil(t, workflow)
+			})
+		}
+	})
+}
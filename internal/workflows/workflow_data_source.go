@@ -0,0 +1,128 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/client"
+	"github.com/splunk/terraform-provider-scp/internal/wait"
+)
+
+const (
+	DataSourceKey = "scp_workflow"
+
+	schemaKeyName              = "name"
+	schemaKeyWaitForCompletion = "wait_for_completion"
+	schemaKeyStatus            = "status"
+	schemaKeyCreatedAt         = "created_at"
+	schemaKeyStartedAt         = "started_at"
+	schemaKeyFinishedAt        = "finished_at"
+)
+
+func workflowDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		schemaKeyName: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the workflow.",
+		},
+		schemaKeyWaitForCompletion: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "If true, the workflow is polled until it reaches a terminal state such as completed, failed or cancelled " +
+				"before its attributes are read. The read timeout bounds the wait. By default the current state of the workflow is read.",
+		},
+		schemaKeyStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the workflow.",
+		},
+		schemaKeyCreatedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The timestamp the workflow was created at.",
+		},
+		schemaKeyStartedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The timestamp the workflow was started at. Empty if the workflow has not started yet.",
+		},
+		schemaKeyFinishedAt: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The timestamp the workflow finished at. Empty if the workflow has not finished yet.",
+		},
+	}
+}
+
+func DataSourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Description: "Workflow Data Source. Use this data source to read the status of a named workflow of the stack, optionally " +
+			"waiting for the workflow to reach a terminal state, for example to await a long-running stack operation explicitly " +
+			"instead of guessing its duration with sleeps. " +
+			"Please refer to https://docs.splunk.com/Documentation/SplunkCloud/latest/Config/ACSIntro " +
+			"for more latest, detailed information on attribute requirements and the ACS API.",
+
+		ReadContext: dataSourceWorkflowRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: workflowDataSourceSchema(),
+	}
+}
+
+func dataSourceWorkflowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// use the meta value to retrieve your client from the provider configure method
+	acsProvider := m.(client.ACSProvider)
+	acsClient := *acsProvider.Client
+	stack := acsProvider.Stack
+
+	workflowName := d.Get(schemaKeyName).(string)
+
+	var workflow *v2.DescribeWorkflowResponseObject
+	var err error
+	if d.Get(schemaKeyWaitForCompletion).(bool) {
+		workflow, err = wait.WaitWorkflowTerminal(ctx, acsClient, stack, workflowName, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return diag.Errorf("Error waiting for workflow (%s) to complete: %s", workflowName, err)
+		}
+	} else {
+		workflow, err = WaitWorkflowRead(ctx, acsClient, stack, workflowName)
+		if err != nil {
+			return diag.Errorf("Error reading workflow (%s): %s", workflowName, err)
+		}
+	}
+
+	for key, value := range FlattenWorkflow(*workflow) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", stack, workflowName))
+
+	return nil
+}
+
+// FlattenWorkflow converts a workflow to the map of its computed attributes
+func FlattenWorkflow(workflow v2.DescribeWorkflowResponseObject) map[string]interface{} {
+	return map[string]interface{}{
+		schemaKeyStatus:     stringValue(workflow.Status),
+		schemaKeyCreatedAt:  stringValue(workflow.CreatedAt),
+		schemaKeyStartedAt:  stringValue(workflow.StartedAt),
+		schemaKeyFinishedAt: stringValue(workflow.FinishedAt),
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,47 @@
+package workflows_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v2 "github.com/splunk/terraform-provider-scp/acs/v2"
+	"github.com/splunk/terraform-provider-scp/internal/acctest"
+	"github.com/splunk/terraform-provider-scp/internal/workflows"
+	"github.com/stretchr/testify/assert"
+)
+
+const workflowDataSourceTemplate = `
+data "scp_workflow" "missing" {
+	name = "terraform-acceptance-test-missing-workflow"
+}
+`
+
+func TestAcc_SplunkCloudWorkflow_DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      workflowDataSourceTemplate,
+				ExpectError: regexp.MustCompile(`Error reading workflow`),
+			},
+		},
+	})
+}
+
+func Test_FlattenWorkflow(t *testing.T) {
+	t.Run("with started workflow", func(t *testing.T) {
+		flattened := workflows.FlattenWorkflow(v2.DescribeWorkflowResponseObject{Status: &mockStatus, CreatedAt: &mockCreatedAt, StartedAt: &mockCreatedAt})
+		assert.Equal(t, mockStatus, flattened["status"])
+		assert.Equal(t, mockCreatedAt, flattened["created_at"])
+		assert.Equal(t, mockCreatedAt, flattened["started_at"])
+		assert.Equal(t, "", flattened["finished_at"])
+	})
+
+	t.Run("with empty workflow", func(t *testing.T) {
+		flattened := workflows.FlattenWorkflow(v2.DescribeWorkflowResponseObject{})
+		assert.Equal(t, "", flattened["status"])
+		assert.Equal(t, "", flattened["created_at"])
+	})
+}